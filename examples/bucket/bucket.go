@@ -22,7 +22,7 @@ func main() {
 	}
 
 	process := res.WaitHandler(ctx, bucket, projectID, bucketName)
-	if _, err := process.WaitWithContext(ctx); err != nil {
+	if _, err := process.Wait(ctx); err != nil {
 		panic(err)
 	}
 
@@ -0,0 +1,10 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package backup
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/backup"
+
+type RestoresCreateResponse struct {
+	backup.ClientWithResponsesInterface
+}
@@ -12,6 +12,6 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *costs.ClientWithResponses {
-	s, _ := costs.NewClient(BaseURLs.Get(), costs.WithHTTPClient(c))
+	s, _ := costs.NewClient(BaseURLs.Get(), costs.WithHTTPClient(contracts.WithUserAgent(c, "costs", "v2.0")))
 	return s
 }
@@ -0,0 +1,52 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists provisions the instance if no instance with body.InstanceName exists and waits until it's ready
+// an existing instance isn't updated, the returned bool reports whether the instance was provisioned
+func EnsureExists(ctx context.Context, c instances.ClientWithResponsesInterface, projectID string, body instances.ProvisionJSONRequestBody) (instanceID string, created bool, err error) {
+	list, err := c.List(ctx, projectID)
+	if err = validate.Response(list, err, "JSON200"); err != nil {
+		return "", false, err
+	}
+	for _, i := range list.JSON200.Instances {
+		if i.InstanceID == nil || i.Name != body.InstanceName {
+			continue
+		}
+		_, err = instances.ProvisionResponse{}.WaitHandler(ctx, c, projectID, *i.InstanceID).Wait(ctx)
+		return *i.InstanceID, false, err
+	}
+
+	res, err := c.Provision(ctx, projectID, body)
+	if err = validate.Response(res, err, "JSON202"); err != nil {
+		return "", false, err
+	}
+	instanceID = res.JSON202.InstanceID
+	_, err = res.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return instanceID, true, err
+}
+
+// EnsureDeleted deprovisions the instance if it exists and waits for the deletion
+// the returned bool reports whether the instance was deprovisioned
+func EnsureDeleted(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, instanceID string) (bool, error) {
+	res, err := c.Get(ctx, projectID, instanceID)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound, http.StatusGone) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Deprovision(ctx, projectID, instanceID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return true, err
+}
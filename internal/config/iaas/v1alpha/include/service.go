@@ -12,5 +12,5 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *iaas.ClientWithResponses {
-	return iaas.NewClient(BaseURLs.Get(), c)
+	return iaas.NewClient(BaseURLs.Get(), contracts.WithUserAgent(c, "iaas", "v1alpha"))
 }
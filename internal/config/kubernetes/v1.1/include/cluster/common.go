@@ -3,7 +3,7 @@
 
 package cluster
 
-import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.0/cluster"
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
 
 type CreateOrUpdateResponse struct {
 	cluster.ClientWithResponsesInterface
@@ -12,3 +12,15 @@ type CreateOrUpdateResponse struct {
 type DeleteResponse struct {
 	cluster.ClientWithResponsesInterface
 }
+
+type TriggerHibernationResponse struct {
+	cluster.ClientWithResponsesInterface
+}
+
+type TriggerWakeupResponse struct {
+	cluster.ClientWithResponsesInterface
+}
+
+type TriggerMaintenanceResponse struct {
+	cluster.ClientWithResponsesInterface
+}
@@ -0,0 +1,48 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists creates the cluster if it doesn't exist and waits until it's ready
+// an existing cluster isn't updated, it returns the cluster name and whether the cluster was created
+func EnsureExists(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string, body cluster.CreateOrUpdateJSONRequestBody) (string, bool, error) {
+	res, err := c.Get(ctx, projectID, clusterName)
+	if err = validate.Response(res, err); err == nil {
+		_, err = (&cluster.CreateOrUpdateResponse{}).WaitHandler(ctx, c, projectID, clusterName).SetInitialDelay(0).Wait(ctx)
+		return clusterName, false, err
+	}
+	if !validate.StatusEquals(res, http.StatusNotFound) {
+		return "", false, err
+	}
+
+	created, err := c.CreateOrUpdate(ctx, projectID, clusterName, body)
+	if err = validate.Response(created, err); err != nil {
+		return "", false, err
+	}
+	_, err = created.WaitHandler(ctx, c, projectID, clusterName).Wait(ctx)
+	return clusterName, true, err
+}
+
+// EnsureDeleted deletes the cluster if it exists and waits for the deletion
+// the returned bool reports whether the cluster was deleted
+func EnsureDeleted(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) (bool, error) {
+	res, err := c.Get(ctx, projectID, clusterName)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, projectID, clusterName)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, clusterName).Wait(ctx)
+	return true, err
+}
@@ -2,58 +2,137 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.0/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
-func (*CreateOrUpdateResponse) WaitHandler(ctx context.Context, c *cluster.ClientWithResponses, projectID, clusterName string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// kinds of the operations described by the wait handlers
+const (
+	kindCreateOrUpdate = "cluster_create_or_update"
+	kindHibernate      = "cluster_hibernate"
+	kindWakeup         = "cluster_wakeup"
+	kindMaintenance    = "cluster_maintenance"
+	kindDelete         = "cluster_delete"
+)
+
+// WaitHandler will wait for cluster creation or update
+// returned value is the last observed *cluster.GetResponse
+func (*CreateOrUpdateResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	return waitForState(ctx, c, projectID, clusterName, cluster.STATE_HEALTHY, cluster.STATE_HIBERNATED).
+		Describe("kubernetes", kindCreateOrUpdate, string(cluster.STATE_HEALTHY), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// WaitHandler will wait for cluster hibernation
+// returned value is the last observed *cluster.GetResponse
+func (*TriggerHibernationResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	return waitForState(ctx, c, projectID, clusterName, cluster.STATE_HIBERNATED).
+		Describe("kubernetes", kindHibernate, string(cluster.STATE_HIBERNATED), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// WaitHandler will wait for cluster wakeup
+// returned value is the last observed *cluster.GetResponse
+func (*TriggerWakeupResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	return waitForState(ctx, c, projectID, clusterName, cluster.STATE_HEALTHY).
+		Describe("kubernetes", kindWakeup, string(cluster.STATE_HEALTHY), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// WaitHandler will wait for cluster maintenance
+// returned value is the last observed *cluster.GetResponse
+func (*TriggerMaintenanceResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	// artificial wait for cluster to change from status healthy to reconciling
+	return waitForState(ctx, c, projectID, clusterName, cluster.STATE_HEALTHY, cluster.STATE_HIBERNATED).SetInitialDelay(10*time.Second).
+		Describe("kubernetes", kindMaintenance, string(cluster.STATE_HEALTHY), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// waitForState waits until the aggregated cluster status is one of the given states
+func waitForState(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string, states ...cluster.ClusterStatusState) *wait.Handler[*cluster.GetResponse] {
+	return wait.NewHandler(func() (res *cluster.GetResponse, done bool, err error) {
 		resp, err := c.Get(ctx, projectID, clusterName)
-		if err != nil {
-			if strings.Contains(err.Error(), http.StatusText(http.StatusForbidden)) {
-				return nil, false, nil
-			}
-			return nil, false, err
-		}
-		if resp.StatusCode() == http.StatusForbidden {
-			return nil, false, nil
-		}
-		if resp.StatusCode() == http.StatusInternalServerError {
-			return nil, false, nil
-		}
-		if resp.Error != nil {
-			return nil, false, resp.Error
+		if err = validate.Response(resp, err, "JSON200.Status.Aggregated"); err != nil {
+			return nil, false, wait.NewRequestError(resp, err)
 		}
 
 		status := *resp.JSON200.Status.Aggregated
-		if status == cluster.STATE_HEALTHY || status == cluster.STATE_HIBERNATED {
-			return resp, true, nil
+		for _, s := range states {
+			if status == s {
+				return resp, true, nil
+			}
 		}
-		return resp, false, nil
-	})
+		return resp, false, clusterFailure(resp.JSON200.Status)
+	}).SetStateFunc(clusterState).SetTransientClassifier(
+		// forbidden is returned until permissions of a new cluster are propagated
+		wait.NewTransientClassifier().WithStatusCodes(http.StatusForbidden),
+	)
 }
 
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *cluster.ClientWithResponses, projectID, clusterName string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for cluster deletion
+// returned value is always empty
+func (*DeleteResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.Get(ctx, projectID, clusterName)
-		if err != nil {
-			if strings.Contains(err.Error(), http.StatusText(http.StatusNotFound)) {
-				return nil, true, nil
+		if err = validate.Response(resp, err); err != nil {
+			if validate.StatusEquals(resp, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
-		if resp.StatusCode() == http.StatusInternalServerError {
-			return nil, false, nil
-		}
-		if resp.Error != nil {
-			if resp.StatusCode() == http.StatusNotFound {
-				return nil, true, nil
-			}
-			return nil, false, err
+		return struct{}{}, false, nil
+	}).Describe("kubernetes", kindDelete, "deleted", map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// clusterFailure returns a *wait.TerminalError if the cluster is unhealthy
+// runtime errors the cluster recovers from on its own are ignored
+func clusterFailure(status *cluster.ClusterStatus) error {
+	if status.Aggregated == nil || *status.Aggregated != cluster.STATE_UNHEALTHY {
+		return nil
+	}
+	te := &wait.TerminalError{State: string(cluster.STATE_UNHEALTHY)}
+	if status.Error == nil {
+		return te
+	}
+	if status.Error.Code != nil {
+		switch *status.Error.Code {
+		case cluster.SKE_TMP_AUTH_ERROR, cluster.SKE_RATE_LIMITS:
+			return nil
 		}
-		return nil, false, nil
-	})
+		te.Code = string(*status.Error.Code)
+	}
+	if status.Error.Message != nil {
+		te.Details = append(te.Details, *status.Error.Message)
+	}
+	if status.Error.Details != nil {
+		te.Details = append(te.Details, *status.Error.Details)
+	}
+	return te
+}
+
+// clusterState returns the aggregated status of an observed cluster
+func clusterState(res *cluster.GetResponse) string {
+	if res == nil || res.JSON200 == nil || res.JSON200.Status == nil || res.JSON200.Status.Aggregated == nil {
+		return ""
+	}
+	return string(*res.JSON200.Status.Aggregated)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreateOrUpdate:
+		return wait.Any((*CreateOrUpdateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	case kindHibernate:
+		return wait.Any((*TriggerHibernationResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	case kindWakeup:
+		return wait.Any((*TriggerWakeupResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	case kindMaintenance:
+		return wait.Any((*TriggerMaintenanceResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
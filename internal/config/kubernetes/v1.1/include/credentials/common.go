@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package credentials
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/credentials"
+
+type StartClusterCredentialsRotationResponse struct {
+	credentials.ClientWithResponsesInterface
+}
+
+type CompleteClusterCredentialsRotationResponse struct {
+	credentials.ClientWithResponsesInterface
+}
@@ -0,0 +1,63 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindStartRotation    = "credentials_start_rotation"
+	kindCompleteRotation = "credentials_complete_rotation"
+)
+
+// WaitHandler will wait for the credentials rotation to be prepared
+// returned value is the last observed *cluster.GetResponse
+func (*StartClusterCredentialsRotationResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	return waitForPhase(ctx, c, projectID, clusterName, cluster.PREPARED).
+		Describe("kubernetes", kindStartRotation, string(cluster.PREPARED), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// WaitHandler will wait for the credentials rotation to be completed
+// returned value is the last observed *cluster.GetResponse
+func (*CompleteClusterCredentialsRotationResponse) WaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string) *wait.Handler[*cluster.GetResponse] {
+	return waitForPhase(ctx, c, projectID, clusterName, cluster.COMPLETED).
+		Describe("kubernetes", kindCompleteRotation, string(cluster.COMPLETED), map[string]string{"projectID": projectID, "clusterName": clusterName})
+}
+
+// waitForPhase waits until the credentials rotation of a cluster reached the given phase
+func waitForPhase(ctx context.Context, c cluster.ClientWithResponsesInterface, projectID, clusterName string, phase cluster.CredentialsRotationPhase) *wait.Handler[*cluster.GetResponse] {
+	return wait.NewHandler(func() (res *cluster.GetResponse, done bool, err error) {
+		resp, err := c.Get(ctx, projectID, clusterName)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, false, wait.NewRequestError(resp, err)
+		}
+		// the phase isn't set until the rotation was started
+		return resp, rotationPhase(resp) == string(phase), nil
+	}).SetStateFunc(rotationPhase)
+}
+
+// rotationPhase returns the credentials rotation phase of an observed cluster
+func rotationPhase(res *cluster.GetResponse) string {
+	if res == nil || res.JSON200 == nil || res.JSON200.Status == nil ||
+		res.JSON200.Status.CredentialsRotation == nil || res.JSON200.Status.CredentialsRotation.Phase == nil {
+		return ""
+	}
+	return string(*res.JSON200.Status.CredentialsRotation.Phase)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c cluster.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindStartRotation:
+		return wait.Any((*StartClusterCredentialsRotationResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	case kindCompleteRotation:
+		return wait.Any((*CompleteClusterCredentialsRotationResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["clusterName"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
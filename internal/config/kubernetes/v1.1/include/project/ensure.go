@@ -0,0 +1,48 @@
+package project
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/project"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists enables SKE for the project if it isn't enabled and waits until it's ready
+// it returns the project ID and whether SKE was enabled for the project
+func EnsureExists(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) (string, bool, error) {
+	res, err := c.Get(ctx, projectID)
+	if err = validate.Response(res, err); err == nil {
+		_, err = (&project.CreateResponse{}).WaitHandler(ctx, c, projectID).SetInitialDelay(0).Wait(ctx)
+		return projectID, false, err
+	}
+	if !validate.StatusEquals(res, http.StatusNotFound) {
+		return "", false, err
+	}
+
+	created, err := c.Create(ctx, projectID)
+	if err = validate.Response(created, err); err != nil {
+		return "", false, err
+	}
+	_, err = created.WaitHandler(ctx, c, projectID).Wait(ctx)
+	return projectID, true, err
+}
+
+// EnsureDeleted disables SKE for the project if it's enabled and waits for the deletion
+// the returned bool reports whether the project was deleted
+func EnsureDeleted(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) (bool, error) {
+	res, err := c.Get(ctx, projectID)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, projectID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID).Wait(ctx)
+	return true, err
+}
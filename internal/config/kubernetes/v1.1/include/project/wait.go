@@ -4,57 +4,67 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.0/project"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/project"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
-	"github.com/pkg/errors"
 )
 
-func (*CreateResponse) WaitHandler(ctx context.Context, c *project.ClientWithResponses, projectID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "project_create"
+	kindDelete = "project_delete"
+)
 
+// WaitHandler will wait for project creation
+// returned value is always empty
+func (*CreateResponse) WaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.Get(ctx, projectID)
-		if err != nil {
-			return nil, false, errors.Wrap(err, "failed during create request preparation")
-		}
-		if resp.Error != nil {
-			if strings.Contains(resp.Error.Error(), "project has no assigned namespace") {
-				return nil, false, nil
-			}
-			return nil, false, err
+		if err = validate.Response(resp, err, "JSON200.State"); err != nil {
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
 
 		switch *resp.JSON200.State {
 		case project.STATE_FAILED:
 			fallthrough
 		case project.STATE_DELETING:
-			return nil, false, fmt.Errorf("received state: %s for project ID: %s",
+			return struct{}{}, false, fmt.Errorf("received state: %s for project ID: %s",
 				*resp.JSON200.State,
-				*resp.JSON200.ProjectID,
+				projectID,
 			)
 		case project.STATE_CREATED:
-			return nil, true, nil
+			return struct{}{}, true, nil
 		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).SetTransientClassifier(
+		wait.NewTransientClassifier().WithMessages("project has no assigned namespace"),
+	).Describe("kubernetes", kindCreate, string(project.STATE_CREATED), map[string]string{"projectID": projectID})
 }
 
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *project.ClientWithResponses, projectID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for project deletion
+// returned value is always empty
+func (*DeleteResponse) WaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.Get(ctx, projectID)
-		if err != nil {
-			if strings.Contains(err.Error(), http.StatusText(http.StatusNotFound)) {
-				return nil, true, nil
+		if err = validate.Response(resp, err); err != nil {
+			if validate.StatusEquals(resp, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
-			return nil, false, errors.Wrap(err, "failed during delete request preparation")
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
-		if resp.Error != nil {
-			if strings.Contains(resp.Error.Error(), http.StatusText(http.StatusNotFound)) {
-				return nil, true, nil
-			}
-			return nil, false, err
-		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("kubernetes", kindDelete, "deleted", map[string]string{"projectID": projectID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
@@ -0,0 +1,55 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/google/uuid"
+)
+
+// EnsureExists creates the load balancer if it doesn't exist and waits until it's ready
+// the load balancer is identified by body.Name, an existing load balancer isn't updated
+// it returns the name of the load balancer and whether the load balancer was created
+func EnsureExists(ctx context.Context, c instances.ClientWithResponsesInterface, projectID string, body instances.CreateJSONRequestBody) (string, bool, error) {
+	if body.Name == nil {
+		return "", false, errors.New("load balancer name must be set")
+	}
+	name := *body.Name
+	res, err := c.Get(ctx, projectID, name)
+	if err = validate.Response(res, err); err == nil {
+		_, err = (&instances.CreateResponse{}).WaitHandler(ctx, c, projectID, name).SetInitialDelay(0).Wait(ctx)
+		return name, false, err
+	}
+	if !validate.StatusEquals(res, http.StatusNotFound) {
+		return "", false, err
+	}
+
+	created, err := c.Create(ctx, projectID, &instances.CreateParams{XRequestID: uuid.New()}, body)
+	if err = validate.Response(created, err); err != nil {
+		return "", false, err
+	}
+	_, err = created.WaitHandler(ctx, c, projectID, name).Wait(ctx)
+	return name, true, err
+}
+
+// EnsureDeleted deletes the load balancer if it exists and waits for the deletion
+// the returned bool reports whether the load balancer was deleted
+func EnsureDeleted(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, name string) (bool, error) {
+	res, err := c.Get(ctx, projectID, name)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, projectID, name)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, name).Wait(ctx)
+	return true, err
+}
@@ -3,7 +3,9 @@ package loadbalancer
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
-	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1beta.0.0"
+	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/project"
 )
 
 var BaseURLs = baseurl.New(
@@ -11,10 +13,23 @@ var BaseURLs = baseurl.New(
 	"https://load-balancer.api.eu01.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *loadbalancer.ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Project   project.ClientWithResponsesInterface
+	Instances instances.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
 	nc, _ := loadbalancer.NewClient(
 		BaseURLs.Get(),
-		loadbalancer.WithHTTPClient(c),
+		loadbalancer.WithHTTPClient(contracts.WithUserAgent(c, "load-balancer", "1.3.0")),
 	)
-	return nc
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Project:   nc.Project,
+		Instances: nc.Instances,
+	}
 }
@@ -2,7 +2,6 @@ package instances
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,56 +10,91 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "instances_create"
+	kindDelete = "instances_delete"
+)
+
 // Wait will wait for instance create to complete
-func (*CreateResponse) WaitHandler(ctx context.Context, c *instances.ClientWithResponses, projectID, name string) *wait.Handler {
+// returned value is the last observed *instances.GetResponse
+func (*CreateResponse) WaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, name string) *wait.Handler[*instances.GetResponse] {
 	maxFailCount := 10
-	return wait.New(func() (res interface{}, done bool, err error) {
+	return wait.NewHandler(func() (res *instances.GetResponse, done bool, err error) {
 		s, err := c.Get(ctx, projectID, name)
 		if err = validate.Response(s, err, "JSON200.Status"); err != nil {
-			return nil, false, err
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		status := *s.JSON200.Status
 		if status == instances.STATUS_READY {
 			return s, true, nil
 		}
 		if status == instances.STATUS_ERROR {
+			// the error status may be resolved by the load balancer itself
 			if maxFailCount == 0 {
-				errorCollection := ""
-				if s != nil && s.JSON200 != nil && s.JSON200.Errors != nil {
-					for _, e := range *s.JSON200.Errors {
-						etype, edesc := "", ""
-						if e.Type != nil {
-							etype = string(*e.Type)
-						}
-						if e.Description != nil {
-							edesc = *e.Description
-						}
-						errorCollection += fmt.Sprintf("%s: %s\n", etype, edesc)
-					}
-				}
-				return s, false, fmt.Errorf("received status %s from server\n%s", status, errorCollection)
+				return s, false, loadBalancerFailure(s.JSON200)
 			}
 			maxFailCount--
 			return s, false, nil
 		}
 		if status == instances.STATUS_TERMINATING {
-			return s, false, errors.New("received status TERMINATING from server")
+			return s, false, loadBalancerFailure(s.JSON200)
 		}
 		return s, false, nil
-	})
+	}).SetStateFunc(instanceState).
+		Describe("load-balancer", kindCreate, string(instances.STATUS_READY), map[string]string{"projectID": projectID, "name": name})
 }
 
 // Wait will wait for instance deletion
-// returned value for deletion wait will always be nil
-func (DeleteResponse) WaitHandler(ctx context.Context, c *instances.ClientWithResponses, projectID, name string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value for deletion wait will always be empty
+func (DeleteResponse) WaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, name string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		res, err := c.Get(ctx, projectID, name)
 		if err = validate.Response(res, err); err != nil {
-			if res != nil && res.StatusCode() == http.StatusNotFound {
-				return nil, true, nil
+			if validate.StatusEquals(res, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(res, err)
+		}
+		return struct{}{}, false, nil
+	}).Describe("load-balancer", kindDelete, "deleted", map[string]string{"projectID": projectID, "name": name})
+}
+
+// loadBalancerFailure returns a *wait.TerminalError with the errors reported for the load balancer
+func loadBalancerFailure(lb *instances.LoadBalancer) error {
+	te := &wait.TerminalError{State: string(*lb.Status)}
+	if lb.Errors == nil {
+		return te
+	}
+	for _, e := range *lb.Errors {
+		etype, edesc := "", ""
+		if e.Type != nil {
+			etype = string(*e.Type)
 		}
-		return nil, false, nil
-	})
+		if e.Description != nil {
+			edesc = *e.Description
+		}
+		te.Details = append(te.Details, fmt.Sprintf("%s: %s", etype, edesc))
+	}
+	return te
+}
+
+// instanceState returns the status of an observed load balancer
+func instanceState(res *instances.GetResponse) string {
+	if res == nil || res.JSON200 == nil || res.JSON200.Status == nil {
+		return ""
+	}
+	return string(*res.JSON200.Status)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["name"])).Resume(op)
+	case kindDelete:
+		return wait.Any(DeleteResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["name"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
@@ -0,0 +1,30 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1beta.0.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the load balancers of a project
+// load balancers are keyed by name
+func NewWatcher(c instances.ClientWithResponsesInterface, projectID string) *watch.Watcher[instances.LoadBalancer] {
+	return watch.New(func(ctx context.Context) (map[string]instances.LoadBalancer, error) {
+		resp, err := c.List(ctx, projectID)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]instances.LoadBalancer{}
+		if resp.JSON200.LoadBalancers == nil {
+			return items, nil
+		}
+		for _, lb := range *resp.JSON200.LoadBalancers {
+			if lb.Name != nil {
+				items[*lb.Name] = lb
+			}
+		}
+		return items, nil
+	})
+}
@@ -4,24 +4,31 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1beta.0.0/project"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
-func (*EnableProjectResponse) WaitHandler(ctx context.Context, c *project.ClientWithResponses, projectID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// kinds of the operations described by the wait handlers
+const (
+	kindEnable  = "project_enable"
+	kindDisable = "project_disable"
+)
+
+// WaitHandler will wait for the project to be enabled
+// returned value is always empty
+func (*EnableProjectResponse) WaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.GetStatus(ctx, projectID)
 		if err = validate.Response(resp, err, "JSON200.Status"); err != nil {
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
 		switch *resp.JSON200.Status {
 		case project.STATUS_FAILED:
 			fallthrough
 		case project.STATUS_DELETING:
-			return nil, false, fmt.Errorf("received state: %s for project ID: %s",
+			return struct{}{}, false, fmt.Errorf("received state: %s for project ID: %s",
 				*resp.JSON200.Status,
 				projectID,
 			)
@@ -29,25 +36,39 @@ func (*EnableProjectResponse) WaitHandler(ctx context.Context, c *project.Client
 			// in some cases beta APIs do not return a status
 			fallthrough
 		case project.STATUS_READY:
-			return nil, true, nil
+			return struct{}{}, true, nil
 		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("load-balancer", kindEnable, string(project.STATUS_READY), map[string]string{"projectID": projectID})
 }
 
-func (*DisableProjectResponse) WaitHandler(ctx context.Context, c *project.ClientWithResponses, projectID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for the project to be disabled
+// returned value is always empty
+func (*DisableProjectResponse) WaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, projectID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.GetStatus(ctx, projectID)
 		if err = validate.Response(resp, err, "JSON200.Status"); err != nil {
-			if strings.Contains(err.Error(), http.StatusText(http.StatusNotFound)) {
-				return nil, true, nil
+			if validate.StatusEquals(resp, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
 		if *resp.JSON200.Status == project.STATUS_DISABLED ||
 			*resp.JSON200.Status == project.STATUS_UNSPECIFIED {
-			return nil, true, nil
+			return struct{}{}, true, nil
 		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("load-balancer", kindDisable, string(project.STATUS_DISABLED), map[string]string{"projectID": projectID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c project.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindEnable:
+		return wait.Any((*EnableProjectResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"])).Resume(op)
+	case kindDisable:
+		return wait.Any((*DisableProjectResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
@@ -0,0 +1,32 @@
+package membership
+
+import (
+	"context"
+	"iter"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// GetUserResourcesIter returns an iterator over all resources of a user matching params
+func GetUserResourcesIter(ctx context.Context, c membership.ClientWithResponsesInterface, email string, params *membership.GetUserResourcesParams) iter.Seq2[membership.Resource, error] {
+	p := membership.GetUserResourcesParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]membership.Resource, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetUserResources(ctx, email, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		if len(res.JSON200.Items) < int(res.JSON200.Limit) {
+			return res.JSON200.Items, "", nil
+		}
+		return res.JSON200.Items, res.JSON200.Cursor, nil
+	})
+}
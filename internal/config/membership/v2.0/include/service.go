@@ -18,5 +18,5 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *membership.ClientWithResponses {
-	return membership.NewClient(BaseURLs.Get(), c)
+	return membership.NewClient(BaseURLs.Get(), contracts.WithUserAgent(c, "membership", "v2.0"))
 }
@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package backup
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/backup"
+
+type CreateRestoreResponse struct {
+	backup.ClientWithResponsesInterface
+}
+
+type CreateCloneResponse struct {
+	backup.ClientWithResponsesInterface
+}
@@ -0,0 +1,43 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindRestore = "backup_restore"
+	kindClone   = "backup_clone"
+)
+
+// WaitHandler will wait for the restored instance to be ready
+// returned value is always empty
+func (CreateRestoreResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	// the restored instance changes from status ready to processing
+	return instance.PutResponse{}.WaitHandler(ctx, c, projectID, instanceID).
+		Describe("mongodb-flex", kindRestore, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// WaitHandler will wait for the cloned instance to be ready
+// instanceID is the ID of the new instance, returned in JSON202.InstanceID
+// returned value is always empty
+func (CreateCloneResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	return instance.CreateResponse{}.WaitHandler(ctx, c, projectID, instanceID).
+		Describe("mongodb-flex", kindClone, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindRestore:
+		return wait.Any(CreateRestoreResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindClone:
+		return wait.Any(CreateCloneResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
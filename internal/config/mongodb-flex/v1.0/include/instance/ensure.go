@@ -0,0 +1,78 @@
+package instance
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists creates the instance if no instance with body.Name exists and waits until it's ready
+// an existing instance isn't updated, the returned bool reports whether the instance was created
+func EnsureExists(ctx context.Context, c instance.ClientWithResponsesInterface, projectID string, body instance.CreateJSONRequestBody) (instanceID string, created bool, err error) {
+	if body.Name == nil {
+		return "", false, errors.New("instance name must be set")
+	}
+	id, err := findByName(ctx, c, projectID, *body.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if id != "" {
+		_, err = instance.CreateResponse{}.WaitHandler(ctx, c, projectID, id).SetInitialDelay(0).Wait(ctx)
+		return id, false, err
+	}
+
+	res, err := c.Create(ctx, projectID, body)
+	if err = validate.Response(res, err, "JSON202.ID"); err != nil {
+		return "", false, err
+	}
+	instanceID = *res.JSON202.ID
+	_, err = res.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return instanceID, true, err
+}
+
+// EnsureDeleted deletes the instance if it exists and waits for the deletion
+// the returned bool reports whether the instance was deleted
+func EnsureDeleted(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) (bool, error) {
+	list, err := c.List(ctx, projectID, &instance.ListParams{})
+	if err = validate.Response(list, err, "JSON200"); err != nil {
+		return false, err
+	}
+	found := false
+	if list.JSON200.Items != nil {
+		for _, i := range *list.JSON200.Items {
+			if i.ID != nil && *i.ID == instanceID {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return false, nil
+	}
+
+	deleted, err := c.Delete(ctx, projectID, instanceID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return true, err
+}
+
+// findByName returns the ID of the instance with the given name or an empty string
+func findByName(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, name string) (string, error) {
+	list, err := c.List(ctx, projectID, &instance.ListParams{})
+	if err = validate.Response(list, err, "JSON200"); err != nil {
+		return "", err
+	}
+	if list.JSON200.Items == nil {
+		return "", nil
+	}
+	for _, i := range *list.JSON200.Items {
+		if i.ID != nil && i.Name != nil && *i.Name == name {
+			return *i.ID, nil
+		}
+	}
+	return "", nil
+}
@@ -1,9 +1,12 @@
 package instance
 
+// Status is the status of an instance
+type Status string
+
+// Instance status options
 const (
-	// Instance status options
-	STATUS_READY      = "READY"
-	STATUS_FAILED     = "FAILED"
-	STATUS_PROCESSING = "PROCESSING"
-	STATUS_UNKNOWN    = "UNKNOWN"
+	STATUS_READY      Status = "READY"
+	STATUS_FAILED     Status = "FAILED"
+	STATUS_PROCESSING Status = "PROCESSING"
+	STATUS_UNKNOWN    Status = "UNKNOWN"
 )
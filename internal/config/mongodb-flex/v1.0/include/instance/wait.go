@@ -3,8 +3,6 @@ package instance
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
@@ -12,49 +10,52 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "instance_create"
+	kindPut    = "instance_put"
+	kindPatch  = "instance_patch"
+	kindDelete = "instance_delete"
+)
+
+// ClientTimeoutErr is the client timeout error message
+//
+// Deprecated: transient errors are classified by wait.TransientClassifier
 const ClientTimeoutErr = "Client.Timeout exceeded while awaiting headers"
 
 // WaitHandler will wait for instance creation to complete
-// returned interface is of *instance.InstanceSingleInstance
-func (r CreateResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return createOrUpdateWait(ctx, c, projectID, instanceID)
+// returned value is always empty
+func (r CreateResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	return createOrUpdateWait(ctx, c, projectID, instanceID).
+		Describe("mongodb-flex", kindCreate, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // WaitHandler will wait for instance update to complete
-// returned interface is nil
-func (r PutResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
+// returned value is always empty
+func (r PutResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
 	// artificial wait for instance to change from status ready to updating
-	time.Sleep(5 * time.Second)
-	return createOrUpdateWait(ctx, c, projectID, instanceID)
+	return createOrUpdateWait(ctx, c, projectID, instanceID).SetInitialDelay(10*time.Second).
+		Describe("mongodb-flex", kindPut, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // WaitHandler will wait for instance update to complete
-// returned interface is nil
-func (r PatchResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
+// returned value is always empty
+func (r PatchResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
 	// artificial wait for instance to change from status ready to updating
-	time.Sleep(5 * time.Second)
-	return createOrUpdateWait(ctx, c, projectID, instanceID)
+	return createOrUpdateWait(ctx, c, projectID, instanceID).SetInitialDelay(10*time.Second).
+		Describe("mongodb-flex", kindPatch, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for instance update to complete
-// returned interface is nil
-func createOrUpdateWait(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	// artificial wait for instance to change status
-	time.Sleep(5 * time.Second)
-
+// returned value is always empty
+func createOrUpdateWait(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
 	outerfound := false
-	return wait.New(func() (res interface{}, done bool, err error) {
+
+	// artificial wait for instance to change status
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		s, err := c.List(ctx, projectID, &instance.ListParams{})
 		if err = validate.Response(s, err, "JSON200.Items"); err != nil {
-			if strings.Contains(err.Error(), ClientTimeoutErr) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(s, err)
 		}
 
 		innerfound := false
@@ -64,40 +65,51 @@ func createOrUpdateWait(ctx context.Context, c *instance.ClientWithResponses, pr
 			}
 			outerfound = true
 			innerfound = true
-			if *item.Status == STATUS_READY {
-				return nil, true, nil
+			if *item.Status == instance.STATUS_FAILED {
+				return struct{}{}, false, &wait.TerminalError{State: string(*item.Status)}
+			}
+			if *item.Status == instance.STATUS_READY {
+				return struct{}{}, true, nil
 			}
 		}
 		if !innerfound && outerfound {
-			return nil, false, fmt.Errorf("instance %s is not in the project's instance list", instanceID)
+			return struct{}{}, false, fmt.Errorf("instance %s is not in the project's instance list", instanceID)
 		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).SetInitialDelay(5 * time.Second)
 }
 
 // WaitHandler will wait for instance deletion
-// returned value for deletion wait will always be nil
-func (DeleteResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value for deletion wait will always be empty
+func (DeleteResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		s, err := c.List(ctx, projectID, &instance.ListParams{})
 		if err = validate.Response(s, err, "JSON200.Items"); err != nil {
-			if strings.Contains(err.Error(), ClientTimeoutErr) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(s, err)
 		}
 		for _, v := range *s.JSON200.Items {
 			if v.ID == nil || *v.ID != instanceID {
 				continue
 			}
 			// instance was found
-			return nil, false, nil
+			return struct{}{}, false, nil
 		}
-		return nil, true, nil
-	})
+		return struct{}{}, true, nil
+	}).Describe("mongodb-flex", kindDelete, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any(CreateResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPut:
+		return wait.Any(PutResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPatch:
+		return wait.Any(PatchResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindDelete:
+		return wait.Any(DeleteResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
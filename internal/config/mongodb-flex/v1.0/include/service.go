@@ -4,6 +4,11 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
 	mongodb "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/backup"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/flavors"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/user"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/versions"
 )
 
 var BaseURLs = baseurl.New(
@@ -11,7 +16,26 @@ var BaseURLs = baseurl.New(
 	"https://mongodb-flex-service.api.eu01.stackit.cloud/v1/",
 )
 
-func NewService(c contracts.BaseClientInterface) *mongodb.ClientWithResponses {
-	nc, _ := mongodb.NewClient(BaseURLs.Get(), mongodb.WithHTTPClient(c))
-	return nc
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Backup   backup.ClientWithResponsesInterface
+	User     user.ClientWithResponsesInterface
+	Versions versions.ClientWithResponsesInterface
+	Flavors  flavors.ClientWithResponsesInterface
+	Instance instance.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
+	nc, _ := mongodb.NewClient(BaseURLs.Get(), mongodb.WithHTTPClient(contracts.WithUserAgent(c, "mongodb-flex", "v1.0")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Backup:   nc.Backup,
+		User:     nc.User,
+		Versions: nc.Versions,
+		Flavors:  nc.Flavors,
+		Instance: nc.Instance,
+	}
 }
@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package user
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/user"
+
+type CreateResponse struct {
+	user.ClientWithResponsesInterface
+}
+
+type DeleteResponse struct {
+	user.ClientWithResponsesInterface
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/user"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "user_create"
+	kindDelete = "user_delete"
+)
+
+// WaitHandler will wait for user creation
+// userID is returned in JSON202.Item.ID
+// returned value is the last observed *user.InstanceResponseUser or nil
+func (CreateResponse) WaitHandler(ctx context.Context, c user.ClientWithResponsesInterface, projectID, instanceID, userID string) *wait.Handler[*user.InstanceResponseUser] {
+	return wait.NewHandler(func() (*user.InstanceResponseUser, bool, error) {
+		s, err := c.Get(ctx, projectID, instanceID, userID)
+		if err = validate.Response(s, err, "JSON200.Item"); err != nil {
+			if validate.StatusEquals(s, http.StatusNotFound) {
+				// user isn't available yet
+				return nil, false, nil
+			}
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		return s.JSON200.Item, true, nil
+	}).Describe("mongodb-flex", kindCreate, "created", map[string]string{"projectID": projectID, "instanceID": instanceID, "userID": userID})
+}
+
+// WaitHandler will wait for user deletion
+// returned value for deletion wait will always be empty
+func (DeleteResponse) WaitHandler(ctx context.Context, c user.ClientWithResponsesInterface, projectID, instanceID, userID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
+		s, err := c.Get(ctx, projectID, instanceID, userID)
+		if err = validate.Response(s, err); err != nil {
+			if validate.StatusEquals(s, http.StatusNotFound) {
+				return struct{}{}, true, nil
+			}
+			return struct{}{}, false, wait.NewRequestError(s, err)
+		}
+		return struct{}{}, false, nil
+	}).Describe("mongodb-flex", kindDelete, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID, "userID": userID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c user.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any(CreateResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"], op.IDs["userID"])).Resume(op)
+	case kindDelete:
+		return wait.Any(DeleteResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"], op.IDs["userID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
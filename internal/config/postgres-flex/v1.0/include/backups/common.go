@@ -0,0 +1,10 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package backups
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/backups"
+
+type UpdateResponse struct {
+	backups.ClientWithResponsesInterface
+}
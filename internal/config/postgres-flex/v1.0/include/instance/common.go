@@ -20,3 +20,7 @@ type PutResponse struct {
 type DeleteResponse struct {
 	instance.ClientWithResponsesInterface
 }
+
+type CreateCloneResponse struct {
+	instance.ClientWithResponsesInterface
+}
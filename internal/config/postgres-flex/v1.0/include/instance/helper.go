@@ -1,8 +1,12 @@
 package instance
 
+// Status is the status of an instance
+type Status string
+
+// Instance status options
 const (
-	// Instance status options
-	STATUS_READY      = "Ready"
-	STATUS_FAILED     = "Failure"
-	STATUS_PROCESSING = "Progressing"
+	STATUS_READY      Status = "Ready"
+	STATUS_FAILED     Status = "Failure"
+	STATUS_PROCESSING Status = "Progressing"
+	STATUS_DELETED    Status = "Deleted"
 )
@@ -2,8 +2,9 @@ package instance
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
@@ -11,53 +12,116 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "instance_create"
+	kindPut    = "instance_put"
+	kindPatch  = "instance_patch"
+	kindClone  = "instance_clone"
+	kindDelete = "instance_delete"
+)
+
 // Wait will wait for instance create to complete
-func (*CreateResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *instance.InstanceSingleInstance
+func (*CreateResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instance.InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindCreate, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for instance update to complete
-func (*PutResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *instance.InstanceSingleInstance
+func (*PutResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instance.InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindPut, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for instance update to complete
-func (*PatchResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *instance.InstanceSingleInstance
+func (*PatchResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instance.InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindPatch, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
-// returned interface is of *instance.InstanceSingleInstance
-func waitForCreateOrUpdate(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	// artifical wait for instance to change from status ready to updating
-	// sometimes stackit takes longer to synnc
-	time.Sleep(30 * time.Second)
+// Wait will wait for the cloned instance to be ready
+// instanceID is the ID of the new instance, returned in JSON201.InstanceID
+// returned value is the last observed *instance.InstanceSingleInstance
+func (*CreateCloneResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instance.InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindClone, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
 
-	return wait.New(func() (res interface{}, done bool, err error) {
+// returned value is the last observed *instance.InstanceSingleInstance
+func waitForCreateOrUpdate(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instance.InstanceSingleInstance] {
+	// artifical wait for instance to change from status ready to updating
+	return wait.NewHandler(func() (res *instance.InstanceSingleInstance, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(s, err, "JSON200.Item"); err != nil {
-			return nil, false, err
+			return nil, false, wait.NewRequestError(s, err)
 		}
-		if *s.JSON200.Item.Status == STATUS_READY {
+		if *s.JSON200.Item.Status == instance.STATUS_READY {
 			return s.JSON200.Item, true, nil
 		}
-		if *s.JSON200.Item.Status == STATUS_FAILED {
-			return s.JSON200.Item, false, errors.New("received status FAILED from server")
+		if *s.JSON200.Item.Status == instance.STATUS_FAILED {
+			return s.JSON200.Item, false, &wait.TerminalError{State: string(*s.JSON200.Item.Status)}
 		}
 		return s.JSON200.Item, false, nil
-	})
+	}).SetStateFunc(instanceState).SetInitialDelay(5 * time.Second)
 }
 
 // Wait will wait for instance deletion
-// returned value for deletion wait will always be nil
-func (DeleteResponse) WaitHandler(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value for deletion wait will always be empty
+func (DeleteResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		res, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(res, err); err != nil {
-			if res != nil && res.StatusCode() == http.StatusNotFound {
-				return nil, true, nil
+			// old handling of not found
+			if validate.StatusEquals(res, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
-			return nil, false, err
+
+			return struct{}{}, false, wait.NewRequestError(res, err)
+		}
+
+		// new soft-deletion
+		if instanceDeleted(res) {
+			return struct{}{}, true, nil
 		}
-		return nil, false, nil
-	})
+
+		return struct{}{}, false, nil
+	}).Describe("postgres-flex", kindDelete, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// instanceState returns the status of an observed instance
+func instanceState(res *instance.InstanceSingleInstance) string {
+	if res == nil || res.Status == nil {
+		return ""
+	}
+	return string(*res.Status)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPut:
+		return wait.Any((*PutResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPatch:
+		return wait.Any((*PatchResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindClone:
+		return wait.Any((*CreateCloneResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindDelete:
+		return wait.Any(DeleteResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
+
+// instanceDeleted reports whether the instance was soft-deleted
+// the status is compared case-insensitively
+func instanceDeleted(res *instance.GetResponse) bool {
+	if res == nil || res.JSON200 == nil || res.JSON200.Item == nil || res.JSON200.Item.Status == nil {
+		return false
+	}
+	return strings.EqualFold(string(*res.JSON200.Item.Status), string(instance.STATUS_DELETED))
 }
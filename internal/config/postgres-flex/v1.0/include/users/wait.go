@@ -2,39 +2,46 @@ package users
 
 import (
 	"context"
-	"net/http"
-	"strings"
+	"fmt"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/users"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kind of the operation described by the wait handler
+const kindDelete = "users_delete"
+
+// ClientTimeoutErr is the client timeout error message
+//
+// Deprecated: transient errors are classified by wait.TransientClassifier
 const ClientTimeoutErr = "Client.Timeout exceeded while awaiting headers"
 
 // Wait will wait for user deletion
-// returned value for deletion wait will always be nil
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *users.ClientWithResponses, projectID, instanceID, userID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value for deletion wait will always be empty
+func (*DeleteResponse) WaitHandler(ctx context.Context, c users.ClientWithResponsesInterface, projectID, instanceID, userID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		s, err := c.List(ctx, projectID, instanceID)
 		if agg := validate.Response(s, err, "JSON200.Items"); agg != nil {
-			if strings.Contains(agg.Error(), ClientTimeoutErr) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
-			return nil, false, agg
+			return struct{}{}, false, wait.NewRequestError(s, agg)
 		}
 		for _, v := range *s.JSON200.Items {
 			if v.ID == nil || *v.ID != userID {
 				continue
 			}
 			// user was found
-			return nil, false, nil
+			return struct{}{}, false, nil
 		}
-		return nil, true, nil
-	})
+		return struct{}{}, true, nil
+	}).Describe("postgres-flex", kindDelete, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID, "userID": userID})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c users.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"], op.IDs["userID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
@@ -0,0 +1,67 @@
+package resourcemanagement
+
+import (
+	"context"
+	"net/http"
+
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists creates the project if no project with body.Name exists in body.ContainerParentID
+// and waits until it's active. projects being deleted are ignored
+// the returned bool reports whether the project was created
+func EnsureExists(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, body resourcemanagement.CreateJSONRequestBody) (containerID string, created bool, err error) {
+	containerID, err = findByName(ctx, c, body.ContainerParentID, body.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if containerID != "" {
+		_, err = (&resourcemanagement.CreateResponse{}).WaitHandler(ctx, c, containerID).Wait(ctx)
+		return containerID, false, err
+	}
+
+	res, err := c.Create(ctx, body)
+	if err = validate.Response(res, err, "JSON201"); err != nil {
+		return "", false, err
+	}
+	containerID = res.JSON201.ContainerID
+	_, err = res.WaitHandler(ctx, c, containerID).Wait(ctx)
+	return containerID, true, err
+}
+
+// EnsureDeleted deletes the project if it exists and waits for the deletion
+// the returned bool reports whether the project was deleted
+func EnsureDeleted(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string) (bool, error) {
+	res, err := c.Get(ctx, containerID, &resourcemanagement.GetParams{})
+	if err = validate.Response(res, err, "JSON200"); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound, http.StatusGone) {
+			return false, nil
+		}
+		return false, err
+	}
+	if res.JSON200.LifecycleState == resourcemanagement.DELETING {
+		_, err = (&resourcemanagement.DeleteResponse{}).WaitHandler(ctx, c, containerID).Wait(ctx)
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, containerID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, containerID).Wait(ctx)
+	return true, err
+}
+
+// findByName returns the container ID of the project with the given name or an empty string
+func findByName(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerParentID, name string) (string, error) {
+	for p, err := range resourcemanagement.ListIter(ctx, c, &resourcemanagement.ListParams{ContainerParentID: &containerParentID}) {
+		if err != nil {
+			return "", err
+		}
+		if p.Name == name && p.LifecycleState != resourcemanagement.DELETING {
+			return p.ContainerID, nil
+		}
+	}
+	return "", nil
+}
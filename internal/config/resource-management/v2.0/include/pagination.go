@@ -0,0 +1,124 @@
+package resourcemanagement
+
+import (
+	"context"
+	"iter"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListIter returns an iterator over all projects matching params
+func ListIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, params *resourcemanagement.ListParams) iter.Seq2[resourcemanagement.ProjectResponse, error] {
+	p := resourcemanagement.ListParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]resourcemanagement.ProjectResponse, int, error) {
+		o := resourcemanagement.Offset(offset)
+		p.Offset = &o
+		res, err := c.List(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetFoldersIter returns an iterator over all folders matching params
+func GetFoldersIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, params *resourcemanagement.GetFoldersParams) iter.Seq2[resourcemanagement.FolderItem, error] {
+	p := resourcemanagement.GetFoldersParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]resourcemanagement.FolderItem, int, error) {
+		o := resourcemanagement.Offset(offset)
+		p.Offset = &o
+		res, err := c.GetFolders(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetAllOrganizationsIter returns an iterator over all organizations matching params
+func GetAllOrganizationsIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, params *resourcemanagement.GetAllOrganizationsParams) iter.Seq2[resourcemanagement.OrganizationItem, error] {
+	p := resourcemanagement.GetAllOrganizationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]resourcemanagement.OrganizationItem, int, error) {
+		o := resourcemanagement.Offset(offset)
+		p.Offset = &o
+		res, err := c.GetAllOrganizations(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetOrganizationsContainerIDSupportIter returns an iterator over all support containers of an organization
+func GetOrganizationsContainerIDSupportIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string, params *resourcemanagement.GetOrganizationsContainerIDSupportParams) iter.Seq2[resourcemanagement.ChildrenItem, error] {
+	p := resourcemanagement.GetOrganizationsContainerIDSupportParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]resourcemanagement.ChildrenItem, int, error) {
+		o := resourcemanagement.Offset(offset)
+		p.Offset = &o
+		res, err := c.GetOrganizationsContainerIDSupport(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetContainersOfAFolderIter returns an iterator over all containers of a folder
+func GetContainersOfAFolderIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string, params *resourcemanagement.GetContainersOfAFolderParams) iter.Seq2[resourcemanagement.ContainerItem, error] {
+	p := resourcemanagement.GetContainersOfAFolderParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]resourcemanagement.ContainerItem, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetContainersOfAFolder(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		return res.JSON200.Items, nextCursor(res.JSON200), nil
+	})
+}
+
+// GetContainersOfAnOrganizationIter returns an iterator over all containers of an organization
+func GetContainersOfAnOrganizationIter(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string, params *resourcemanagement.GetContainersOfAnOrganizationParams) iter.Seq2[resourcemanagement.ContainerItem, error] {
+	p := resourcemanagement.GetContainersOfAnOrganizationParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]resourcemanagement.ContainerItem, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetContainersOfAnOrganization(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		return res.JSON200.Items, nextCursor(res.JSON200), nil
+	})
+}
+
+// nextCursor returns the cursor of the next page or an empty string if the page is the last one
+func nextCursor(res *resourcemanagement.ContainerResponse) string {
+	if float32(len(res.Items)) < res.Limit {
+		return ""
+	}
+	return res.Cursor
+}
@@ -14,7 +14,7 @@ var BaseURLs = baseurl.New(
 func NewService(c contracts.BaseClientInterface) *resourcemanagement.ClientWithResponses {
 	nc, _ := resourcemanagement.NewClient(
 		BaseURLs.Get(),
-		resourcemanagement.WithHTTPClient(c),
+		resourcemanagement.WithHTTPClient(contracts.WithUserAgent(c, "resource-management", "v2.0")),
 	)
 	return nc
 }
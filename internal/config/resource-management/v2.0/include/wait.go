@@ -2,29 +2,27 @@ package resourcemanagement
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
-func (*CreateResponse) WaitHandler(ctx context.Context, c *resourcemanagement.ClientWithResponses, containerID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "project_create"
+	kindDelete = "project_delete"
+)
+
+// WaitHandler will wait for project creation
+// returned value is the last observed *resourcemanagement.GetResponse
+func (*CreateResponse) WaitHandler(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string) *wait.Handler[*resourcemanagement.GetResponse] {
+	return wait.NewHandler(func() (*resourcemanagement.GetResponse, bool, error) {
 		project, err := c.Get(ctx, containerID, &resourcemanagement.GetParams{})
-		if err != nil {
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "403") {
-				return project, false, nil
-			}
-			return project, false, err
-		}
-		if project.StatusCode() == http.StatusForbidden {
-			return project, false, nil
-		}
-		if project.JSON200 == nil {
-			return nil, false, errors.New("received an empty response, JSON200 == nil")
+		if err = validate.Response(project, err, "JSON200"); err != nil {
+			return project, false, wait.NewRequestError(project, err)
 		}
 		switch project.JSON200.LifecycleState {
 		case resourcemanagement.ACTIVE:
@@ -33,18 +31,45 @@ func (*CreateResponse) WaitHandler(ctx context.Context, c *resourcemanagement.Cl
 			return project, false, nil
 		}
 		return project, false, fmt.Errorf("received project state '%s'. aborting", project.JSON200.LifecycleState)
-	})
+	}).SetStateFunc(projectState).SetTransientClassifier(
+		// not found and forbidden are returned until the new project is propagated
+		wait.NewTransientClassifier().WithStatusCodes(http.StatusNotFound, http.StatusForbidden),
+	).Describe("resource-management", kindCreate, string(resourcemanagement.ACTIVE), map[string]string{"containerID": containerID})
 }
 
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *resourcemanagement.ClientWithResponses, containerID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// WaitHandler will wait for project deletion
+// returned value is the last observed *resourcemanagement.GetResponse
+func (*DeleteResponse) WaitHandler(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, containerID string) *wait.Handler[*resourcemanagement.GetResponse] {
+	return wait.NewHandler(func() (*resourcemanagement.GetResponse, bool, error) {
 		project, err := c.Get(ctx, containerID, &resourcemanagement.GetParams{})
-		if err != nil {
-			return project, true, nil
-		}
-		if project.StatusCode() == http.StatusNotFound {
-			return project, true, nil
+		if err = validate.Response(project, err); err != nil {
+			// access to the project is lost once it's deleted
+			if validate.StatusEquals(project, http.StatusNotFound, http.StatusForbidden, http.StatusGone) {
+				return project, true, nil
+			}
+			return project, false, wait.NewRequestError(project, err)
 		}
 		return project, false, nil
-	})
+	}).SetStateFunc(projectState).
+		Describe("resource-management", kindDelete, "deleted", map[string]string{"containerID": containerID})
+}
+
+// projectState returns the lifecycle state of an observed project
+func projectState(res *resourcemanagement.GetResponse) string {
+	if res == nil || res.JSON200 == nil {
+		return ""
+	}
+	return string(res.JSON200.LifecycleState)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c resourcemanagement.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["containerID"])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["containerID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
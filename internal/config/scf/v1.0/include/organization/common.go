@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package organization
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization"
+
+type CreateOrganizationResponse struct {
+	organization.ClientWithResponsesInterface
+}
+
+type DeleteOrganizationResponse struct {
+	organization.ClientWithResponsesInterface
+}
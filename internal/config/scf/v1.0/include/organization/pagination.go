@@ -0,0 +1,36 @@
+package organization
+
+import (
+	"context"
+	"iter"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListOrganizationsIter returns an iterator over all organizations of a project matching params
+func ListOrganizationsIter(ctx context.Context, c organization.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, params *organization.ListOrganizationsParams) iter.Seq2[organization.OrganizationsListItem, error] {
+	p := organization.ListOrganizationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Page(func(page int) ([]organization.OrganizationsListItem, int, error) {
+		n := int64(page)
+		p.Page = &n
+		res, err := c.ListOrganizations(ctx, projectID, region, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Resources, totalPages(res.JSON200.Pagination), nil
+	})
+}
+
+// totalPages returns the total number of pages or 0 if unknown
+func totalPages(p organization.Pagination) int {
+	if p.TotalPages == nil {
+		return 0
+	}
+	return int(*p.TotalPages)
+}
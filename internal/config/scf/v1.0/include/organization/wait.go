@@ -0,0 +1,113 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "organization_create"
+	kindDelete = "organization_delete"
+)
+
+const (
+	// Status prefixes of an organization
+	STATUS_CREATING      = "creating"
+	STATUS_CREATE_FAILED = "create_failed"
+	STATUS_DELETING      = "deleting"
+	STATUS_DELETE_FAILED = "delete_failed"
+)
+
+// WaitHandler will wait for organization creation
+// the organization is created once its status is set and neither creating nor failed
+// organizationID is returned in JSON202.GUID
+// returned value is the last observed *organization.Organization or nil
+func (*CreateOrganizationResponse) WaitHandler(ctx context.Context, c organization.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, organizationID openapiTypes.UUID) *wait.Handler[*organization.Organization] {
+	return wait.NewHandler(func() (res *organization.Organization, done bool, err error) {
+		s, err := c.GetOrganization(ctx, projectID, region, organizationID)
+		if err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		switch s.StatusCode() {
+		case http.StatusOK:
+			if s.JSON200 == nil {
+				return nil, false, nil
+			}
+			return s.JSON200, organizationCreated(s.JSON200), organizationCreateFailure(s.JSON200)
+		case http.StatusNotFound:
+			// organization isn't available yet
+			return nil, false, nil
+		}
+		return nil, false, wait.NewRequestError(s, fmt.Errorf("unexpected status code %d", s.StatusCode()))
+	}).SetStateFunc(organizationState).
+		Describe("scf", kindCreate, "created", map[string]string{"projectID": projectID.String(), "region": region, "organizationID": organizationID.String()})
+}
+
+// WaitHandler will wait for organization deletion
+// returned value is nil once deleted, otherwise the last observed *organization.Organization
+func (*DeleteOrganizationResponse) WaitHandler(ctx context.Context, c organization.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, organizationID openapiTypes.UUID) *wait.Handler[*organization.Organization] {
+	return wait.NewHandler(func() (res *organization.Organization, done bool, err error) {
+		s, err := c.GetOrganization(ctx, projectID, region, organizationID)
+		if err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		switch s.StatusCode() {
+		case http.StatusOK:
+			if s.JSON200 != nil && strings.HasPrefix(s.JSON200.Status, STATUS_DELETE_FAILED) {
+				return s.JSON200, false, &wait.TerminalError{State: s.JSON200.Status}
+			}
+			return s.JSON200, false, nil
+		case http.StatusNotFound:
+			return nil, true, nil
+		}
+		return nil, false, wait.NewRequestError(s, fmt.Errorf("unexpected status code %d", s.StatusCode()))
+	}).SetStateFunc(organizationState).
+		Describe("scf", kindDelete, "deleted", map[string]string{"projectID": projectID.String(), "region": region, "organizationID": organizationID.String()})
+}
+
+// organizationState returns the status of an observed organization
+func organizationState(res *organization.Organization) string {
+	if res == nil {
+		return ""
+	}
+	return res.Status
+}
+
+// organizationCreated reports whether the organization left the creation states
+func organizationCreated(res *organization.Organization) bool {
+	return res.Status != "" && !strings.HasPrefix(res.Status, STATUS_CREATING)
+}
+
+// organizationCreateFailure returns a *wait.TerminalError if the organization won't be created
+// an organization being deleted is considered failed as well
+func organizationCreateFailure(res *organization.Organization) error {
+	for _, prefix := range []string{STATUS_CREATE_FAILED, STATUS_DELETING, STATUS_DELETE_FAILED} {
+		if strings.HasPrefix(res.Status, prefix) {
+			return &wait.TerminalError{State: res.Status}
+		}
+	}
+	return nil
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c organization.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	ids, err := op.UUIDs("projectID", "organizationID")
+	if err != nil {
+		return nil, err
+	}
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateOrganizationResponse)(nil).WaitHandler(ctx, c, ids[0], op.IDs["region"], ids[1])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteOrganizationResponse)(nil).WaitHandler(ctx, c, ids[0], op.IDs["region"], ids[1])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
@@ -0,0 +1,36 @@
+package platform
+
+import (
+	"context"
+	"iter"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/platform"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListPlatformsIter returns an iterator over all platforms of a project matching params
+func ListPlatformsIter(ctx context.Context, c platform.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, params *platform.ListPlatformsParams) iter.Seq2[platform.Platforms, error] {
+	p := platform.ListPlatformsParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Page(func(page int) ([]platform.Platforms, int, error) {
+		n := int64(page)
+		p.Page = &n
+		res, err := c.ListPlatforms(ctx, projectID, region, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Resources, totalPages(res.JSON200.Pagination), nil
+	})
+}
+
+// totalPages returns the total number of pages or 0 if unknown
+func totalPages(p platform.Pagination) int {
+	if p.TotalPages == nil {
+		return 0
+	}
+	return int(*p.TotalPages)
+}
@@ -4,6 +4,14 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
 	scf "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization"
+	organizationmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization-manager"
+	organizationroles "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/organization-roles"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/platform"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/quotas"
+	regionwide "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/region-wide"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/space"
+	spaceroles "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/space-roles"
 )
 
 var BaseURLs = baseurl.New(
@@ -11,7 +19,32 @@ var BaseURLs = baseurl.New(
 	"https://scf.api.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *scf.ClientWithResponses {
-	nc, _ := scf.NewClient(BaseURLs.Get(), scf.WithHTTPClient(c))
-	return nc
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Organization        organization.ClientWithResponsesInterface
+	OrganizationManager organizationmanager.ClientWithResponsesInterface
+	Quotas              quotas.ClientWithResponsesInterface
+	OrganizationRoles   organizationroles.ClientWithResponsesInterface
+	Space               space.ClientWithResponsesInterface
+	SpaceRoles          spaceroles.ClientWithResponsesInterface
+	Platform            platform.ClientWithResponsesInterface
+	RegionWide          regionwide.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
+	nc, _ := scf.NewClient(BaseURLs.Get(), scf.WithHTTPClient(contracts.WithUserAgent(c, "scf", "v1.0")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Organization:        nc.Organization,
+		OrganizationManager: nc.OrganizationManager,
+		Quotas:              nc.Quotas,
+		OrganizationRoles:   nc.OrganizationRoles,
+		Space:               nc.Space,
+		SpaceRoles:          nc.SpaceRoles,
+		Platform:            nc.Platform,
+		RegionWide:          nc.RegionWide,
+	}
 }
@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package space
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/space"
+
+type CreateSpaceResponse struct {
+	space.ClientWithResponsesInterface
+}
+
+type DeleteSpaceResponse struct {
+	space.ClientWithResponsesInterface
+}
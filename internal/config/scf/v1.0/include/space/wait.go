@@ -0,0 +1,71 @@
+package space
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0/space"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "space_create"
+	kindDelete = "space_delete"
+)
+
+// WaitHandler will wait for the space to be available
+// spaceID is returned in JSON201.GUID
+// returned value is the last observed *space.Space or nil
+func (*CreateSpaceResponse) WaitHandler(ctx context.Context, c space.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, organizationID, spaceID openapiTypes.UUID) *wait.Handler[*space.Space] {
+	return wait.NewHandler(func() (res *space.Space, done bool, err error) {
+		s, err := c.GetSpace(ctx, projectID, region, organizationID, spaceID)
+		if err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		switch s.StatusCode() {
+		case http.StatusOK:
+			return s.JSON200, s.JSON200 != nil, nil
+		case http.StatusNotFound:
+			// space isn't available yet
+			return nil, false, nil
+		}
+		return nil, false, wait.NewRequestError(s, fmt.Errorf("unexpected status code %d", s.StatusCode()))
+	}).Describe("scf", kindCreate, "created", map[string]string{"projectID": projectID.String(), "region": region, "organizationID": organizationID.String(), "spaceID": spaceID.String()})
+}
+
+// WaitHandler will wait for space deletion
+// returned value for deletion wait will always be empty
+func (*DeleteSpaceResponse) WaitHandler(ctx context.Context, c space.ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, organizationID, spaceID openapiTypes.UUID) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
+		s, err := c.GetSpace(ctx, projectID, region, organizationID, spaceID)
+		if err != nil {
+			return struct{}{}, false, wait.NewRequestError(s, err)
+		}
+		switch s.StatusCode() {
+		case http.StatusOK:
+			return struct{}{}, false, nil
+		case http.StatusNotFound:
+			return struct{}{}, true, nil
+		}
+		return struct{}{}, false, wait.NewRequestError(s, fmt.Errorf("unexpected status code %d", s.StatusCode()))
+	}).Describe("scf", kindDelete, "deleted", map[string]string{"projectID": projectID.String(), "region": region, "organizationID": organizationID.String(), "spaceID": spaceID.String()})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c space.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	ids, err := op.UUIDs("projectID", "organizationID", "spaceID")
+	if err != nil {
+		return nil, err
+	}
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateSpaceResponse)(nil).WaitHandler(ctx, c, ids[0], op.IDs["region"], ids[1], ids[2])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteSpaceResponse)(nil).WaitHandler(ctx, c, ids[0], op.IDs["region"], ids[1], ids[2])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
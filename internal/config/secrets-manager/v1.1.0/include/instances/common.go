@@ -0,0 +1,14 @@
+// this file is only used to prevent wait.go
+// from showing errors
+
+package instances
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+
+type CreateResponse struct {
+	instances.ClientWithResponsesInterface
+}
+
+type DeleteResponse struct {
+	instances.ClientWithResponsesInterface
+}
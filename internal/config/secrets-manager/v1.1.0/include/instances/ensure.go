@@ -0,0 +1,59 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/google/uuid"
+)
+
+// EnsureExists creates the instance if no instance with body.Name exists and waits until it's active
+// the returned bool reports whether the instance was created
+func EnsureExists(ctx context.Context, c instances.ClientWithResponsesInterface, projectID openapiTypes.UUID, body instances.CreateJSONRequestBody) (instanceID openapiTypes.UUID, created bool, err error) {
+	list, err := c.List(ctx, projectID)
+	if err = validate.Response(list, err, "JSON200"); err != nil {
+		return instanceID, false, err
+	}
+	for _, i := range list.JSON200.Instances {
+		if i.Name != body.Name {
+			continue
+		}
+		if instanceID, err = uuid.Parse(i.ID); err != nil {
+			return instanceID, false, err
+		}
+		_, err = (&instances.CreateResponse{}).WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+		return instanceID, false, err
+	}
+
+	res, err := c.Create(ctx, projectID, body)
+	if err = validate.Response(res, err, "JSON201"); err != nil {
+		return instanceID, false, err
+	}
+	if instanceID, err = uuid.Parse(res.JSON201.ID); err != nil {
+		return instanceID, false, err
+	}
+	_, err = res.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return instanceID, true, err
+}
+
+// EnsureDeleted deletes the instance if it exists and waits for the deletion
+// the returned bool reports whether the instance was deleted
+func EnsureDeleted(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, instanceID openapiTypes.UUID) (bool, error) {
+	res, err := c.Get(ctx, projectID, instanceID)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, projectID, instanceID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return true, err
+}
@@ -0,0 +1,77 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "instances_create"
+	kindDelete = "instances_delete"
+)
+
+// WaitHandler will wait for instance creation
+// returned value is the last observed *instances.Instance or nil
+func (*CreateResponse) WaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, instanceID openapiTypes.UUID) *wait.Handler[*instances.Instance] {
+	return wait.NewHandler(func() (res *instances.Instance, done bool, err error) {
+		s, err := c.Get(ctx, projectID, instanceID)
+		if err = validate.Response(s, err, "JSON200"); err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		switch instances.State(s.JSON200.State) {
+		case instances.STATE_ACTIVE:
+			return s.JSON200, true, nil
+		case instances.STATE_FAILED:
+			return s.JSON200, false, errors.New("received state failed from server")
+		}
+		return s.JSON200, false, nil
+	}).SetStateFunc(instanceState).
+		Describe("secrets-manager", kindCreate, string(instances.STATE_ACTIVE), map[string]string{"projectID": projectID.String(), "instanceID": instanceID.String()})
+}
+
+// WaitHandler will wait for instance deletion
+// returned value for deletion wait will always be empty
+func (*DeleteResponse) WaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, instanceID openapiTypes.UUID) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
+		s, err := c.Get(ctx, projectID, instanceID)
+		if err = validate.Response(s, err); err != nil {
+			if validate.StatusEquals(s, http.StatusNotFound) {
+				return struct{}{}, true, nil
+			}
+			return struct{}{}, false, wait.NewRequestError(s, err)
+		}
+		return struct{}{}, false, nil
+	}).Describe("secrets-manager", kindDelete, "deleted", map[string]string{"projectID": projectID.String(), "instanceID": instanceID.String()})
+}
+
+// instanceState returns the state of an observed instance
+func instanceState(res *instances.Instance) string {
+	if res == nil {
+		return ""
+	}
+	return res.State
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	ids, err := op.UUIDs("projectID", "instanceID")
+	if err != nil {
+		return nil, err
+	}
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, ids[0], ids[1])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, ids[0], ids[1])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
 	secretsmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/acls"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/users"
 )
 
 var BaseURLs = baseurl.New(
@@ -11,10 +14,25 @@ var BaseURLs = baseurl.New(
 	"https://secrets-manager.api.eu01.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *secretsmanager.ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Instances instances.ClientWithResponsesInterface
+	Acls      acls.ClientWithResponsesInterface
+	Users     users.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
 	nc, _ := secretsmanager.NewClient(
 		BaseURLs.Get(),
-		secretsmanager.WithHTTPClient(c),
+		secretsmanager.WithHTTPClient(contracts.WithUserAgent(c, "secrets-manager", "v1.1.0")),
 	)
-	return nc
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Instances: nc.Instances,
+		Acls:      nc.Acls,
+		Users:     nc.Users,
+	}
 }
@@ -12,5 +12,5 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *serviceaccounts.ClientWithResponses {
-	return serviceaccounts.NewClient(BaseURLs.Get(), c)
+	return serviceaccounts.NewClient(BaseURLs.Get(), contracts.WithUserAgent(c, "service-accounts", "v2.0"))
 }
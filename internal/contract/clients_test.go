@@ -0,0 +1,232 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	argusinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	lbinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/bucket"
+	postgresinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	smInstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const projectID = "5dae0612-f5b1-4615-b7ca-b18796aa7e78"
+
+// doer is a minimal contracts.BaseClientInterface for the generated clients
+type doer struct {
+	*http.Client
+}
+
+func (doer) GetServiceAccountEmail() string { return "" }
+
+func (d doer) Clone() interface{} { return d }
+
+// newServer starts a validating server for the spec of the given target, i.e. kubernetes/v1.1
+func newServer(t *testing.T, name string) (*Server, doer) {
+	targets, err := Targets(root)
+	require.NoError(t, err)
+	for _, target := range targets {
+		if target.Name != name {
+			continue
+		}
+		spec, err := Load(target.Spec)
+		require.NoError(t, err)
+		srv := NewServer(spec)
+		t.Cleanup(srv.Close)
+		return srv, doer{srv.Client()}
+	}
+	require.FailNow(t, "unknown target", name)
+	return nil, doer{}
+}
+
+// exampleBody decodes the example request body of an operation into out
+func exampleBody(t *testing.T, srv *Server, method, path string, out interface{}) {
+	op := srv.spec.Find(method, path)
+	require.NotNil(t, op, "%s %s isn't defined in the spec", method, path)
+	require.NotNil(t, op.Body, "%s %s has no request body", method, path)
+	b, err := json.Marshal(srv.spec.Example(op.Body))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, out))
+}
+
+// assertCalls checks that the server received the expected operations without validation errors
+func assertCalls(t *testing.T, srv *Server, operations ...string) {
+	calls := srv.Calls()
+	require.Len(t, calls, len(operations))
+	for i, call := range calls {
+		if assert.NotNil(t, call.Operation, "%s %s", call.Method, call.Path) {
+			assert.Equal(t, operations[i], call.Operation.Method+" "+call.Operation.Path)
+		}
+		assert.Empty(t, call.Errors, "%s %s", call.Method, call.Path)
+	}
+}
+
+func TestClients_Kubernetes(t *testing.T) {
+	srv, d := newServer(t, "kubernetes/v1.1")
+	c := cluster.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	list, err := c.List(ctx, projectID)
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+
+	var body cluster.CreateOrUpdateJSONRequestBody
+	exampleBody(t, srv, http.MethodPut, "/v1/projects/{projectId}/clusters/{clusterName}", &body)
+	res, err := c.CreateOrUpdate(ctx, projectID, "my-cluster", body)
+	require.NoError(t, validate.Response(res, err, "JSON200"))
+
+	get, err := c.Get(ctx, projectID, "my-cluster")
+	require.NoError(t, validate.Response(get, err, "JSON200"))
+
+	assertCalls(t, srv,
+		"GET /v1/projects/{projectId}/clusters",
+		"PUT /v1/projects/{projectId}/clusters/{clusterName}",
+		"GET /v1/projects/{projectId}/clusters/{clusterName}",
+	)
+}
+
+func TestClients_ResourceManagement(t *testing.T) {
+	srv, d := newServer(t, "resource-management/v2.0")
+	c, err := resourcemanagement.NewClient(srv.URL, resourcemanagement.WithHTTPClient(d))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	limit, offset, member, start := resourcemanagement.Limit(10), resourcemanagement.Offset(5), "user@example.com", "2023-01-01T00:00:00Z"
+	list, err := c.List(ctx, &resourcemanagement.ListParams{
+		Limit:             &limit,
+		Offset:            &offset,
+		Member:            &member,
+		CreationTimeStart: &start,
+	})
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+
+	var body resourcemanagement.CreateJSONRequestBody
+	exampleBody(t, srv, http.MethodPost, "/projects", &body)
+	res, err := c.Create(ctx, body)
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+
+	assertCalls(t, srv, "GET /projects", "POST /projects")
+}
+
+func TestClients_PostgresFlex(t *testing.T) {
+	srv, d := newServer(t, "postgres-flex/v1.0")
+	c := postgresinstance.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	var body postgresinstance.CreateJSONRequestBody
+	exampleBody(t, srv, http.MethodPost, "/v1/projects/{projectId}/instances", &body)
+	res, err := c.Create(ctx, projectID, body)
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+
+	list, err := c.List(ctx, projectID)
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+
+	assertCalls(t, srv, "POST /v1/projects/{projectId}/instances", "GET /v1/projects/{projectId}/instances")
+}
+
+func TestClients_LoadBalancer(t *testing.T) {
+	srv, d := newServer(t, "load-balancer/1.3.0")
+	c := lbinstances.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	var body lbinstances.CreateJSONRequestBody
+	exampleBody(t, srv, http.MethodPost, "/v1/projects/{projectId}/load-balancers", &body)
+	res, err := c.Create(ctx, projectID, &lbinstances.CreateParams{XRequestID: uuid.New()}, body)
+	require.NoError(t, validate.Response(res, err, "JSON200"))
+
+	assertCalls(t, srv, "POST /v1/projects/{projectId}/load-balancers")
+}
+
+func TestClients_ObjectStorage(t *testing.T) {
+	srv, d := newServer(t, "object-storage/v1.0.1")
+	c := bucket.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	res, err := c.Create(ctx, projectID, "my-bucket")
+	require.NoError(t, validate.Response(res, err))
+
+	get, err := c.Get(ctx, projectID, "my-bucket")
+	require.NoError(t, validate.Response(get, err, "JSON200"))
+
+	assertCalls(t, srv, "POST /v1/project/{projectId}/bucket/{bucketName}", "GET /v1/project/{projectId}/bucket/{bucketName}")
+}
+
+func TestClients_SecretsManager(t *testing.T) {
+	srv, d := newServer(t, "secrets-manager/v1.1.0")
+	c := smInstances.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	var body smInstances.CreateJSONRequestBody
+	exampleBody(t, srv, http.MethodPost, "/v1/projects/{projectId}/instances", &body)
+	res, err := c.Create(ctx, uuid.MustParse(projectID), body)
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+
+	assertCalls(t, srv, "POST /v1/projects/{projectId}/instances")
+}
+
+func TestClients_DataServices(t *testing.T) {
+	srv, d := newServer(t, "data-services/v1.0")
+	c := instances.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	var body instances.ProvisionJSONRequestBody
+	exampleBody(t, srv, http.MethodPost, "/v1/projects/{projectId}/instances", &body)
+	res, err := c.Provision(ctx, projectID, body)
+	require.NoError(t, validate.Response(res, err, "JSON202"))
+
+	list, err := c.List(ctx, projectID)
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+
+	assertCalls(t, srv, "POST /v1/projects/{projectId}/instances", "GET /v1/projects/{projectId}/instances")
+}
+
+func TestClients_Argus(t *testing.T) {
+	srv, d := newServer(t, "argus/v1.0")
+	c := argusinstances.NewClient(srv.URL, d)
+	ctx := context.Background()
+
+	list, err := c.List(ctx, projectID)
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+
+	assertCalls(t, srv, "GET /v1/projects/{projectId}/instances")
+}
+
+func TestServer_Rejects(t *testing.T) {
+	srv, d := newServer(t, "resource-management/v2.0")
+	c, err := resourcemanagement.NewClient(srv.URL, resourcemanagement.WithHTTPClient(d))
+	require.NoError(t, err)
+
+	// the body is missing all required properties
+	_, err = c.Create(context.Background(), resourcemanagement.CreateJSONRequestBody{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/projects?limit=abc&unknown=1", nil)
+	require.NoError(t, err)
+	res, err := d.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	req, err = http.NewRequest(http.MethodPatch, srv.URL+"/unknown", nil)
+	require.NoError(t, err)
+	res, err = d.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	calls := srv.Calls()
+	require.Len(t, calls, 3)
+	assert.NotEmpty(t, calls[0].Errors)
+	assert.Contains(t, calls[1].Errors, "query parameter unknown isn't defined")
+	assert.Contains(t, calls[1].Errors, "query parameter limit: expected number, got string")
+	assert.Nil(t, calls[2].Operation)
+	assert.Equal(t, []string{"no operation for PATCH /unknown"}, calls[2].Errors)
+}
@@ -0,0 +1,54 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const root = "../.."
+
+func TestTargets(t *testing.T) {
+	targets, err := Targets(root)
+	require.NoError(t, err)
+	require.NotEmpty(t, targets)
+
+	for _, target := range targets {
+		assert.FileExists(t, target.Spec)
+		assert.DirExists(t, target.Package)
+	}
+}
+
+// TestGeneratedRequests checks that every request built by a generated client
+// uses a method, path, query and header parameters defined in its spec
+func TestGeneratedRequests(t *testing.T) {
+	targets, err := Targets(root)
+	require.NoError(t, err)
+
+	for _, target := range targets {
+		target := target
+		t.Run(target.Name, func(t *testing.T) {
+			spec, err := Load(target.Spec)
+			require.NoError(t, err)
+			requests, err := ParseRequests(target.Package)
+			require.NoError(t, err)
+			require.NotEmpty(t, requests, "no request functions found in %s", target.Package)
+
+			for _, r := range requests {
+				op := spec.Find(r.Method, r.Path)
+				if !assert.NotNil(t, op, "%s: %s %s isn't defined in the spec", r.Func, r.Method, r.Path) {
+					continue
+				}
+				for _, q := range r.Query {
+					_, ok := op.Parameter(q, "query")
+					assert.True(t, ok, "%s: query parameter %s isn't defined for %s %s", r.Func, q, op.Method, op.Path)
+				}
+				for _, h := range r.Headers {
+					_, ok := op.Parameter(h, "header")
+					assert.True(t, ok, "%s: header %s isn't defined for %s %s", r.Func, h, op.Method, op.Path)
+				}
+			}
+		})
+	}
+}
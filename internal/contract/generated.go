@@ -0,0 +1,126 @@
+package contract
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Request describes the request built by a generated New*Request function
+type Request struct {
+	Func    string   // name of the function, i.e. NewGetClusterRequestWithBody
+	File    string   // file declaring the function
+	Method  string   // HTTP method
+	Path    string   // operation path, with %s for path parameters
+	Query   []string // names of the query parameters
+	Headers []string // names of the header parameters
+}
+
+// ParseRequests parses the requests built by the generated request functions
+// in dir and all of its sub directories
+func ParseRequests(dir string) ([]Request, error) {
+	res := []Request{}
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Body == nil || !isRequestFunc(fn.Name.Name) {
+				continue
+			}
+			if r, ok := parseRequest(fn); ok {
+				r.File = path
+				res = append(res, r)
+			}
+		}
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Func < res[j].Func
+	})
+	return res, err
+}
+
+func isRequestFunc(name string) bool {
+	return strings.HasPrefix(name, "New") && (strings.HasSuffix(name, "Request") || strings.HasSuffix(name, "RequestWithBody"))
+}
+
+// parseRequest extracts the request built by fn
+// functions that only delegate to another request function are skipped
+func parseRequest(fn *ast.FuncDecl) (Request, bool) {
+	r := Request{Func: fn.Name.Name}
+	found := false
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return true
+			}
+			if id, ok := n.Lhs[0].(*ast.Ident); !ok || id.Name != "operationPath" {
+				return true
+			}
+			if call, ok := n.Rhs[0].(*ast.CallExpr); ok && isCall(call, "fmt", "Sprintf") && len(call.Args) > 0 {
+				r.Path, found = stringLit(call.Args[0])
+			}
+		case *ast.CallExpr:
+			switch {
+			case isCall(n, "http", "NewRequestWithContext") && len(n.Args) > 1:
+				r.Method, _ = stringLit(n.Args[1])
+			case isCall(n, "http", "NewRequest") && len(n.Args) > 0:
+				r.Method, _ = stringLit(n.Args[0])
+			case isCall(n, "runtime", "StyleParamWithLocation") && len(n.Args) > 3:
+				if loc, ok := n.Args[3].(*ast.SelectorExpr); ok && loc.Sel.Name == "ParamLocationQuery" {
+					if name, ok := stringLit(n.Args[2]); ok {
+						r.Query = append(r.Query, name)
+					}
+				}
+			case isHeaderSet(n):
+				if name, ok := stringLit(n.Args[0]); ok {
+					r.Headers = append(r.Headers, name)
+				}
+			}
+		}
+		return true
+	})
+	return r, found && r.Method != ""
+}
+
+// isCall reports whether call calls pkg.name
+func isCall(call *ast.CallExpr, pkg, name string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	return ok && id.Name == pkg
+}
+
+// isHeaderSet reports whether call calls req.Header.Set
+func isHeaderSet(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Set" || len(call.Args) != 2 {
+		return false
+	}
+	header, ok := sel.X.(*ast.SelectorExpr)
+	return ok && header.Sel.Name == "Header"
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
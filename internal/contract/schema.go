@@ -0,0 +1,281 @@
+package contract
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxExampleDepth limits the nesting of generated examples, for recursive schemas
+const maxExampleDepth = 8
+
+// Validate validates a decoded JSON value against a schema of the spec
+// it returns a description of every violation, prefixed by the location in the value
+func (s *Spec) Validate(schema node, v interface{}) []string {
+	return s.validate("$", schema, v)
+}
+
+func (s *Spec) validate(at string, schema node, v interface{}) []string {
+	schema, _ = s.resolve(schema).(node)
+	if schema == nil {
+		return nil
+	}
+
+	errs := []string{}
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			sub, _ := sub.(node)
+			errs = append(errs, s.validate(at, sub, v)...)
+		}
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if any, ok := schema[key].([]interface{}); ok && !s.validAny(at, any, v) {
+			errs = append(errs, fmt.Sprintf("%s: value doesn't match any schema of %s", at, key))
+		}
+	}
+
+	if v == nil {
+		if !nullable(schema) && len(types(schema)) > 0 {
+			errs = append(errs, fmt.Sprintf("%s: null isn't allowed", at))
+		}
+		return errs
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !contains(enum, v) {
+		errs = append(errs, fmt.Sprintf("%s: %v isn't one of %v", at, v, enum))
+	}
+
+	t := typeOf(v)
+	allowed := types(schema)
+	if len(allowed) > 0 && !matchesType(allowed, t, v) {
+		return append(errs, fmt.Sprintf("%s: expected %s, got %s", at, strings.Join(allowed, " or "), t))
+	}
+
+	switch t {
+	case "object":
+		errs = append(errs, s.validateObject(at, schema, v.(node))...)
+	case "array":
+		items, _ := schema["items"].(node)
+		for i, item := range v.([]interface{}) {
+			errs = append(errs, s.validate(fmt.Sprintf("%s[%d]", at, i), items, item)...)
+		}
+	}
+	return errs
+}
+
+func (s *Spec) validateObject(at string, schema node, obj node) []string {
+	errs := []string{}
+	required, _ := schema["required"].([]interface{})
+	for _, r := range required {
+		name, _ := r.(string)
+		if _, ok := obj[name]; !ok {
+			errs = append(errs, fmt.Sprintf("%s: required property %s is missing", at, name))
+		}
+	}
+
+	props, _ := schema["properties"].(node)
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if p, ok := props[k]; ok {
+			p, _ := p.(node)
+			errs = append(errs, s.validate(at+"."+k, p, obj[k])...)
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				errs = append(errs, fmt.Sprintf("%s: property %s isn't defined", at, k))
+			}
+		case node:
+			errs = append(errs, s.validate(at+"."+k, additional, obj[k])...)
+		}
+	}
+	return errs
+}
+
+func (s *Spec) validAny(at string, schemas []interface{}, v interface{}) bool {
+	for _, sub := range schemas {
+		sub, _ := sub.(node)
+		if len(s.validate(at, sub, v)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Example returns an example value for a schema
+// examples given in the spec are used where available
+func (s *Spec) Example(schema node) interface{} {
+	return s.example(schema, 0)
+}
+
+func (s *Spec) example(schema node, depth int) interface{} {
+	schema, _ = s.resolve(schema).(node)
+	if schema == nil || depth > maxExampleDepth {
+		return nil
+	}
+	if e, ok := schema["example"]; ok {
+		return e
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		res := node{}
+		for _, sub := range all {
+			sub, _ := sub.(node)
+			if e, ok := s.example(sub, depth+1).(node); ok {
+				for k, v := range e {
+					res[k] = v
+				}
+			}
+		}
+		return res
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if any, ok := schema[key].([]interface{}); ok && len(any) > 0 {
+			sub, _ := any[0].(node)
+			return s.example(sub, depth+1)
+		}
+	}
+
+	t := ""
+	for _, candidate := range types(schema) {
+		if candidate != "null" {
+			t = candidate
+			break
+		}
+	}
+	if t == "" && schema["properties"] != nil {
+		t = "object"
+	}
+
+	switch t {
+	case "object":
+		res := node{}
+		props, _ := schema["properties"].(node)
+		for k, p := range props {
+			p, _ := p.(node)
+			if e := s.example(p, depth+1); e != nil {
+				res[k] = e
+			}
+		}
+		if additional, ok := schema["additionalProperties"].(node); ok && len(props) == 0 {
+			if e := s.example(additional, depth+1); e != nil {
+				res["key"] = e
+			}
+		}
+		return res
+	case "array":
+		items, _ := schema["items"].(node)
+		if e := s.example(items, depth+1); e != nil {
+			return []interface{}{e}
+		}
+		return []interface{}{}
+	case "string":
+		return stringExample(schema)
+	case "integer", "number":
+		if min, ok := schema["minimum"].(float64); ok {
+			return math.Ceil(min)
+		}
+		return float64(1)
+	case "boolean":
+		return true
+	}
+	return nil
+}
+
+// stringExample returns an example string for the format of a schema
+func stringExample(schema node) string {
+	format, _ := schema["format"].(string)
+	switch format {
+	case "uuid":
+		return "5dae0612-f5b1-4615-b7ca-b18796aa7e78"
+	case "date-time":
+		return "2023-01-01T00:00:00Z"
+	case "date":
+		return "2023-01-01"
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	case "ipv4":
+		return "192.0.2.1"
+	}
+	return "string"
+}
+
+// types returns the allowed types of a schema
+// OpenAPI 3.1 allows a list of types
+func types(schema node) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		res := []string{}
+		for _, v := range t {
+			if v, ok := v.(string); ok {
+				res = append(res, v)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+func nullable(schema node) bool {
+	if n, ok := schema["nullable"].(bool); ok && n {
+		return true
+	}
+	for _, t := range types(schema) {
+		if t == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+// typeOf returns the JSON type of a decoded value
+func typeOf(v interface{}) string {
+	switch v.(type) {
+	case node:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func matchesType(allowed []string, t string, v interface{}) bool {
+	for _, a := range allowed {
+		if a == t {
+			return true
+		}
+		if a == "integer" && t == "number" && v.(float64) == math.Trunc(v.(float64)) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []interface{}, v interface{}) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
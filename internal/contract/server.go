@@ -0,0 +1,199 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Call is a request received by a Server
+type Call struct {
+	Method    string
+	Path      string
+	Operation *Operation // nil if no operation of the spec matched the request
+	Errors    []string
+}
+
+// Server is a test server that validates requests against a spec
+// and answers with the example of the first successful response
+type Server struct {
+	*httptest.Server
+	spec  *Spec
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewServer starts a new validating server for the spec
+func NewServer(spec *Spec) *Server {
+	s := &Server{spec: spec}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Calls returns the requests received so far
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call{}, s.calls...)
+}
+
+// LastCall returns the last request received
+func (s *Server) LastCall() (Call, bool) {
+	calls := s.Calls()
+	if len(calls) == 0 {
+		return Call{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	call := Call{Method: r.Method, Path: r.URL.Path}
+	defer func() {
+		s.mu.Lock()
+		s.calls = append(s.calls, call)
+		s.mu.Unlock()
+	}()
+
+	op, params := s.spec.Match(r.Method, r.URL.Path)
+	if op == nil {
+		call.Errors = append(call.Errors, fmt.Sprintf("no operation for %s %s", r.Method, r.URL.Path))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	call.Operation = op
+	call.Errors = append(call.Errors, s.validateRequest(op, params, r)...)
+
+	code, res := op.SuccessResponse()
+	status, err := strconv.Atoi(code)
+	if err != nil {
+		status = http.StatusOK
+	}
+	if res == nil || res.Schema == nil {
+		w.WriteHeader(status)
+		return
+	}
+	example := res.Example
+	if example == nil {
+		example = s.spec.Example(res.Schema)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(example)
+}
+
+// validateRequest validates parameters and body of a request to op
+func (s *Server) validateRequest(op *Operation, params map[string]string, r *http.Request) []string {
+	errs := []string{}
+	for name, value := range params {
+		p, ok := op.Parameter(name, "path")
+		if !ok {
+			errs = append(errs, fmt.Sprintf("path parameter %s isn't defined", name))
+			continue
+		}
+		errs = append(errs, s.validateParam(p, []string{value})...)
+	}
+
+	query := r.URL.Query()
+	for name, values := range query {
+		p, ok := op.Parameter(name, "query")
+		if !ok {
+			errs = append(errs, fmt.Sprintf("query parameter %s isn't defined", name))
+			continue
+		}
+		errs = append(errs, s.validateParam(p, values)...)
+	}
+	for _, p := range op.Parameters {
+		switch {
+		case !p.Required:
+		case p.In == "query" && !query.Has(p.Name):
+			errs = append(errs, fmt.Sprintf("required query parameter %s is missing", p.Name))
+		case p.In == "header" && r.Header.Get(p.Name) == "":
+			errs = append(errs, fmt.Sprintf("required header %s is missing", p.Name))
+		}
+	}
+
+	if op.Body == nil {
+		return errs
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return append(errs, err.Error())
+	}
+	if len(b) == 0 {
+		if op.BodyRequired {
+			errs = append(errs, "request body is missing")
+		}
+		return errs
+	}
+	if !strings.Contains(r.Header.Get("Content-Type"), "json") {
+		errs = append(errs, fmt.Sprintf("unexpected content type %q", r.Header.Get("Content-Type")))
+	}
+	var body interface{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return append(errs, fmt.Sprintf("request body isn't valid JSON: %s", err))
+	}
+	for _, e := range s.spec.Validate(op.Body, body) {
+		errs = append(errs, "request body "+e)
+	}
+	return errs
+}
+
+// validateParam validates the serialized values of a parameter against its schema
+func (s *Server) validateParam(p Parameter, values []string) []string {
+	schema, _ := s.spec.resolve(p.Schema).(node)
+	if schema == nil {
+		return nil
+	}
+	var v interface{}
+	if contains([]interface{}{"array"}, firstType(schema)) {
+		items, _ := s.spec.resolve(schema["items"]).(node)
+		list := []interface{}{}
+		for _, value := range values {
+			for _, part := range strings.Split(value, ",") {
+				list = append(list, parseScalar(items, part))
+			}
+		}
+		v = list
+	} else {
+		if len(values) > 1 {
+			return []string{fmt.Sprintf("%s parameter %s is repeated", p.In, p.Name)}
+		}
+		v = parseScalar(schema, values[0])
+	}
+
+	errs := []string{}
+	for _, e := range s.spec.Validate(schema, v) {
+		errs = append(errs, fmt.Sprintf("%s parameter %s%s", p.In, p.Name, strings.TrimPrefix(e, "$")))
+	}
+	return errs
+}
+
+// parseScalar parses a serialized parameter value as the type of its schema
+// values that can't be parsed are returned as string, so validation reports them
+func parseScalar(schema node, value string) interface{} {
+	switch firstType(schema) {
+	case "integer", "number":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+func firstType(schema node) string {
+	for _, t := range types(schema) {
+		if t != "null" {
+			return t
+		}
+	}
+	return ""
+}
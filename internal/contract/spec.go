@@ -0,0 +1,280 @@
+// Package contract checks the generated clients against the OpenAPI specs they were generated from
+// it loads the specs in internal/config, validates requests and generates example payloads
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// node is a JSON object of a spec
+type node = map[string]interface{}
+
+// Spec is a loaded OpenAPI 3 spec
+type Spec struct {
+	raw        node
+	Operations []*Operation
+}
+
+// Operation is a single operation of a spec
+type Operation struct {
+	ID           string
+	Method       string
+	Path         string
+	Parameters   []Parameter
+	Body         node // JSON schema of the request body, nil if the operation has no JSON body
+	BodyRequired bool
+	Responses    map[string]*Response
+}
+
+// Parameter is a path, query or header parameter of an operation
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Schema   node
+}
+
+// Response is a response of an operation
+type Response struct {
+	Schema  node        // JSON schema, nil if the response has no JSON content
+	Example interface{} // example from the spec, nil if there is none
+}
+
+// Load loads the spec at the given path
+func Load(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Spec{}
+	if err := json.Unmarshal(b, &s.raw); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	paths, _ := s.raw["paths"].(node)
+	for path, v := range paths {
+		item, _ := s.resolve(v).(node)
+		common := s.parameters(item["parameters"])
+		for method, o := range item {
+			op, ok := o.(node)
+			if !ok || method == "parameters" {
+				continue
+			}
+			s.Operations = append(s.Operations, s.operation(strings.ToUpper(method), path, op, common))
+		}
+	}
+	sort.Slice(s.Operations, func(i, j int) bool {
+		if s.Operations[i].Path == s.Operations[j].Path {
+			return s.Operations[i].Method < s.Operations[j].Method
+		}
+		return s.Operations[i].Path < s.Operations[j].Path
+	})
+	return s, nil
+}
+
+// Find returns the operation with the given method and path template
+// path parameters can be given as {name} or as %s, as in generated request functions
+func (s *Spec) Find(method, path string) *Operation {
+	for _, op := range s.Operations {
+		if op.Method == method && op.Template() == template(path) {
+			return op
+		}
+	}
+	return nil
+}
+
+// Match returns the operation serving a request with the given method and URL path
+// and the values of its path parameters
+// if multiple operations match, the one with the most literal segments wins
+func (s *Spec) Match(method, path string) (*Operation, map[string]string) {
+	var best *Operation
+	var bestParams map[string]string
+	bestLiterals := -1
+	for _, op := range s.Operations {
+		if op.Method != method {
+			continue
+		}
+		params, literals, ok := matchPath(op.Path, path)
+		if ok && literals > bestLiterals {
+			best, bestParams, bestLiterals = op, params, literals
+		}
+	}
+	return best, bestParams
+}
+
+// Template returns the path with all parameters replaced by %s
+func (o *Operation) Template() string {
+	return template(o.Path)
+}
+
+// Parameter returns the parameter with the given name and location
+func (o *Operation) Parameter(name, in string) (Parameter, bool) {
+	for _, p := range o.Parameters {
+		if p.In == in && (p.Name == name || (in == "header" && strings.EqualFold(p.Name, name))) {
+			return p, true
+		}
+	}
+	return Parameter{}, false
+}
+
+// SuccessResponse returns the status code and the first successful response
+func (o *Operation) SuccessResponse() (string, *Response) {
+	codes := []string{}
+	for code := range o.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return "", nil
+	}
+	sort.Strings(codes)
+	return codes[0], o.Responses[codes[0]]
+}
+
+func (s *Spec) operation(method, path string, op node, common []Parameter) *Operation {
+	o := &Operation{
+		Method:    method,
+		Path:      path,
+		Responses: map[string]*Response{},
+	}
+	o.ID, _ = op["operationId"].(string)
+
+	o.Parameters = append(o.Parameters, common...)
+	for _, p := range s.parameters(op["parameters"]) {
+		if _, ok := o.Parameter(p.Name, p.In); !ok {
+			o.Parameters = append(o.Parameters, p)
+		}
+	}
+
+	if body, ok := s.resolve(op["requestBody"]).(node); ok {
+		o.Body, _ = s.jsonContent(body)
+		o.BodyRequired, _ = body["required"].(bool)
+	}
+
+	responses, _ := op["responses"].(node)
+	for code, v := range responses {
+		res, _ := s.resolve(v).(node)
+		schema, media := s.jsonContent(res)
+		r := &Response{Schema: schema}
+		if media != nil {
+			r.Example = media["example"]
+			if examples, ok := media["examples"].(node); ok && r.Example == nil {
+				for _, e := range examples {
+					if e, ok := s.resolve(e).(node); ok {
+						r.Example = e["value"]
+						break
+					}
+				}
+			}
+		}
+		o.Responses[code] = r
+	}
+	return o
+}
+
+func (s *Spec) parameters(v interface{}) []Parameter {
+	res := []Parameter{}
+	list, _ := v.([]interface{})
+	for _, p := range list {
+		p, ok := s.resolve(p).(node)
+		if !ok {
+			continue
+		}
+		param := Parameter{}
+		param.Name, _ = p["name"].(string)
+		param.In, _ = p["in"].(string)
+		param.Required, _ = p["required"].(bool)
+		param.Schema, _ = s.resolve(p["schema"]).(node)
+		res = append(res, param)
+	}
+	return res
+}
+
+// jsonContent returns the schema and media type object of the JSON content of a request body or response
+func (s *Spec) jsonContent(n node) (node, node) {
+	content, _ := n["content"].(node)
+	for mime, v := range content {
+		if !strings.Contains(mime, "json") {
+			continue
+		}
+		media, _ := v.(node)
+		schema, _ := s.resolve(media["schema"]).(node)
+		return schema, media
+	}
+	return nil, nil
+}
+
+// Resolve follows local references, i.e. #/components/schemas/Cluster
+func (s *Spec) Resolve(v interface{}) interface{} {
+	return s.resolve(v)
+}
+
+// resolve follows local references, i.e. #/components/schemas/Cluster
+func (s *Spec) resolve(v interface{}) interface{} {
+	for i := 0; i < 32; i++ {
+		n, ok := v.(node)
+		if !ok {
+			return v
+		}
+		ref, ok := n["$ref"].(string)
+		if !ok {
+			return v
+		}
+		v = s.lookup(ref)
+	}
+	return v
+}
+
+func (s *Spec) lookup(ref string) interface{} {
+	var cur interface{} = s.raw
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		n, ok := cur.(node)
+		if !ok {
+			return nil
+		}
+		part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+		cur = n[part]
+	}
+	return cur
+}
+
+// template replaces path parameters with %s
+func template(path string) string {
+	parts := strings.Split(path, "/")
+	for i, p := range parts {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			parts[i] = "%s"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// matchPath matches a URL path against a path template
+// it returns the path parameters and the number of literal segments
+func matchPath(tmpl, path string) (map[string]string, int, bool) {
+	t := strings.Split(strings.Trim(tmpl, "/"), "/")
+	p := strings.Split(strings.Trim(path, "/"), "/")
+	if len(t) != len(p) {
+		return nil, 0, false
+	}
+	params := map[string]string{}
+	literals := 0
+	for i := range t {
+		if strings.HasPrefix(t[i], "{") && strings.HasSuffix(t[i], "}") {
+			if p[i] == "" {
+				return nil, 0, false
+			}
+			params[strings.Trim(t[i], "{}")] = p[i]
+			continue
+		}
+		if t[i] != p[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
@@ -0,0 +1,160 @@
+package contract
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "openapi": "3.0.1",
+  "paths": {
+    "/v1/projects/{projectId}/items": {
+      "parameters": [{"name": "projectId", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "get": {
+        "parameters": [{"name": "limit", "in": "query", "schema": {"type": "integer"}}],
+        "responses": {"200": {"description": "ok", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/List"}}}}}
+      },
+      "post": {
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Item"}}}},
+        "responses": {
+          "400": {"description": "bad request"},
+          "201": {"description": "created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Item"}, "example": {"name": "spec-example"}}}}
+        }
+      }
+    },
+    "/v1/projects/{projectId}/items/latest": {
+      "get": {"responses": {"204": {"description": "no content"}}}
+    },
+    "/v1/projects/{projectId}/items/{itemId}": {
+      "get": {"responses": {"204": {"description": "no content"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Item": {
+        "type": "object",
+        "required": ["name"],
+        "additionalProperties": false,
+        "properties": {
+          "name": {"type": "string"},
+          "id": {"type": "string", "format": "uuid"},
+          "size": {"type": "integer", "minimum": 3},
+          "state": {"type": "string", "enum": ["active", "deleting"]},
+          "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+          "parent": {"type": "string", "nullable": true}
+        }
+      },
+      "List": {"type": "object", "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/Item"}}}}
+    }
+  }
+}`
+
+func loadTestSpec(t *testing.T) *Spec {
+	path := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, os.WriteFile(path, []byte(testSpec), 0o600))
+	s, err := Load(path)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSpec_Find(t *testing.T) {
+	s := loadTestSpec(t)
+	require.Len(t, s.Operations, 4)
+
+	op := s.Find("GET", "/v1/projects/%s/items")
+	require.NotNil(t, op)
+	assert.Equal(t, "/v1/projects/{projectId}/items", op.Path)
+	_, ok := op.Parameter("projectId", "path")
+	assert.True(t, ok, "path level parameters should be inherited")
+	_, ok = op.Parameter("limit", "query")
+	assert.True(t, ok)
+
+	assert.NotNil(t, s.Find("POST", "/v1/projects/{projectId}/items"))
+	assert.Nil(t, s.Find("DELETE", "/v1/projects/%s/items"))
+
+	code, res := s.Find("POST", "/v1/projects/%s/items").SuccessResponse()
+	assert.Equal(t, "201", code)
+	assert.Equal(t, map[string]interface{}{"name": "spec-example"}, res.Example)
+}
+
+func TestSpec_Match(t *testing.T) {
+	s := loadTestSpec(t)
+
+	op, params := s.Match("GET", "/v1/projects/p/items/latest")
+	require.NotNil(t, op)
+	assert.Equal(t, "/v1/projects/{projectId}/items/latest", op.Path, "literal segments should win")
+	assert.Equal(t, map[string]string{"projectId": "p"}, params)
+
+	op, params = s.Match("GET", "/v1/projects/p/items/i")
+	require.NotNil(t, op)
+	assert.Equal(t, map[string]string{"projectId": "p", "itemId": "i"}, params)
+
+	op, _ = s.Match("GET", "/v1/projects/p")
+	assert.Nil(t, op)
+}
+
+func TestSpec_Validate(t *testing.T) {
+	s := loadTestSpec(t)
+	item := s.Find("POST", "/v1/projects/%s/items").Body
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want []string
+	}{
+		{"ok", node{"name": "a", "size": float64(3), "labels": node{"k": "v"}, "parent": nil}, []string{}},
+		{"missing required", node{}, []string{"$: required property name is missing"}},
+		{"unknown property", node{"name": "a", "foo": true}, []string{"$: property foo isn't defined"}},
+		{"wrong type", node{"name": float64(1)}, []string{"$.name: expected string, got number"}},
+		{"not an integer", node{"name": "a", "size": 1.5}, []string{"$.size: expected integer, got number"}},
+		{"enum", node{"name": "a", "state": "gone"}, []string{"$.state: gone isn't one of [active deleting]"}},
+		{"additional properties", node{"name": "a", "labels": node{"k": true}}, []string{"$.labels.k: expected string, got boolean"}},
+		{"null", node{"name": nil}, []string{"$.name: null isn't allowed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.Validate(item, tt.v))
+		})
+	}
+}
+
+func TestSpec_Example(t *testing.T) {
+	s := loadTestSpec(t)
+
+	for _, op := range s.Operations {
+		if op.Body != nil {
+			assert.Empty(t, s.Validate(op.Body, s.Example(op.Body)), "%s %s", op.Method, op.Path)
+		}
+		for code, res := range op.Responses {
+			if res.Schema != nil {
+				assert.Empty(t, s.Validate(res.Schema, s.Example(res.Schema)), "%s %s %s", op.Method, op.Path, code)
+			}
+		}
+	}
+
+	e := s.Example(s.Find("POST", "/v1/projects/%s/items").Body).(node)
+	assert.Equal(t, float64(3), e["size"])
+	assert.Equal(t, "active", e["state"])
+	assert.Equal(t, "5dae0612-f5b1-4615-b7ca-b18796aa7e78", e["id"])
+}
+
+func TestParseRequests(t *testing.T) {
+	requests, err := ParseRequests("../../pkg/services/load-balancer/1.3.0/instances")
+	require.NoError(t, err)
+
+	var create *Request
+	for i, r := range requests {
+		assert.NotContains(t, []string{"NewCreateRequest"}, r.Func, "request functions delegating to WithBody should be skipped")
+		if r.Func == "NewCreateRequestWithBody" {
+			create = &requests[i]
+		}
+	}
+	require.NotNil(t, create)
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "/v1/projects/%s/load-balancers", create.Path)
+	assert.Equal(t, []string{"X-Request-ID"}, create.Headers)
+}
@@ -0,0 +1,59 @@
+package contract
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var (
+	specPattern   = regexp.MustCompile(`-config (\S+) (\S+\.json)`)
+	outputPattern = regexp.MustCompile(`(?m)^output:\s*(\S+)`)
+)
+
+// Target is a spec and the package generated from it
+type Target struct {
+	Name    string // service and version, i.e. kubernetes/v1.1
+	Spec    string // path to the spec
+	Package string // directory of the generated package
+}
+
+// Targets discovers the specs under root/internal/config
+// and the packages generated from them, using the go:generate directives
+func Targets(root string) ([]Target, error) {
+	files, err := filepath.Glob(filepath.Join(root, "internal", "config", "*", "*", "generate.go"))
+	if err != nil {
+		return nil, err
+	}
+	res := []Target{}
+	for _, f := range files {
+		dir := filepath.Dir(f)
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		m := specPattern.FindSubmatch(b)
+		if m == nil {
+			return nil, fmt.Errorf("%s has no generate directive", f)
+		}
+		cfg, err := os.ReadFile(filepath.Join(dir, string(m[1])))
+		if err != nil {
+			return nil, err
+		}
+		out := outputPattern.FindSubmatch(cfg)
+		if out == nil {
+			return nil, fmt.Errorf("%s has no output", filepath.Join(dir, string(m[1])))
+		}
+		name, err := filepath.Rel(filepath.Join(root, "internal", "config"), dir)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Target{
+			Name:    filepath.ToSlash(name),
+			Spec:    filepath.Join(dir, string(m[2])),
+			Package: filepath.Dir(filepath.Join(dir, string(out[1]))),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package specs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Change is a difference between two specs
+type Change struct {
+	Op   string // "+" added, "-" removed or "~" changed
+	Path string // JSON pointer of the changed value
+}
+
+func (c Change) String() string {
+	return c.Op + " " + c.Path
+}
+
+// Diff returns the changes from a to b, sorted by path
+// added and removed values are reported once, without their children
+func Diff(a, b interface{}) []Change {
+	return diff("", a, b)
+}
+
+func diff(p string, a, b interface{}) []Change {
+	switch ta := a.(type) {
+	case map[string]interface{}:
+		tb, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		res := []Change{}
+		for _, k := range sortedKeys(merged(ta, tb)) {
+			cp := p + "/" + escape(k)
+			va, inA := ta[k]
+			vb, inB := tb[k]
+			switch {
+			case !inA:
+				res = append(res, Change{"+", cp})
+			case !inB:
+				res = append(res, Change{"-", cp})
+			default:
+				res = append(res, diff(cp, va, vb)...)
+			}
+		}
+		return res
+	case []interface{}:
+		tb, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		res := []Change{}
+		for i := 0; i < len(ta) || i < len(tb); i++ {
+			cp := p + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(ta):
+				res = append(res, Change{"+", cp})
+			case i >= len(tb):
+				res = append(res, Change{"-", cp})
+			default:
+				res = append(res, diff(cp, ta[i], tb[i])...)
+			}
+		}
+		return res
+	}
+	if equalJSON(a, b) {
+		return nil
+	}
+	if p == "" {
+		p = "/"
+	}
+	return []Change{{"~", p}}
+}
+
+func merged(a, b map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	for k := range a {
+		res[k] = nil
+	}
+	for k := range b {
+		res[k] = nil
+	}
+	return res
+}
+
+// escape escapes a key of a JSON pointer
+func escape(k string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(k, "~", "~0"), "/", "~1")
+}
+
+// equalJSON reports whether a and b encode to the same JSON
+func equalJSON(a, b interface{}) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
+
+// Decode decodes a JSON spec, numbers are kept as json.Number
+func Decode(b []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var doc interface{}
+	if err := d.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Encode encodes a spec as indented JSON
+func Encode(doc interface{}) ([]byte, error) {
+	b := &bytes.Buffer{}
+	e := json.NewEncoder(b)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", "  ")
+	if err := e.Encode(doc); err != nil {
+		return nil, fmt.Errorf("encoding spec: %w", err)
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,347 @@
+package specs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// node is a value matched by a path, set replaces the value in its parent
+type node struct {
+	value  interface{}
+	parent map[string]interface{} // parent object, nil if the value is an array item or the root
+	key    string                 // key of the value in parent
+	set    func(interface{})
+}
+
+// segment selects the children of a node
+type segment struct {
+	recursive bool        // the segment applies to the node and all of its descendants
+	wildcard  bool        // all children
+	name      *string     // child by name
+	index     *int        // array item by index
+	filter    *filterExpr // children matching the filter
+}
+
+// filterExpr is a filter of the form `@.a.b == 'c'`, `@ != 1` or `@.a` (existence)
+type filterExpr struct {
+	path    []segment // relative path, only names and indexes
+	op      string    // "==", "!=" or "" for existence
+	literal interface{}
+}
+
+// jsonPath is a compiled JSONPath expression
+// the supported subset is `$`, `.name`, `['name']`, `.*`, `[*]`, `[0]`, `..` and `[?(@.name == 'value')]`
+type jsonPath []segment
+
+// compile compiles a JSONPath expression
+func compile(expr string) (jsonPath, error) {
+	p := &parser{s: strings.TrimSpace(expr)}
+	if !p.consume("$") {
+		return nil, fmt.Errorf("%q: path must start with $", expr)
+	}
+	res, err := p.segments(false)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", expr, err)
+	}
+	return res, nil
+}
+
+// find returns the nodes of doc matched by the path
+func (p jsonPath) find(doc *interface{}) []node {
+	nodes := []node{{value: *doc, set: func(v interface{}) { *doc = v }}}
+	for _, s := range p {
+		next := []node{}
+		for _, n := range nodes {
+			if s.recursive {
+				for _, d := range descendants(n) {
+					next = append(next, s.apply(d)...)
+				}
+				continue
+			}
+			next = append(next, s.apply(n)...)
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// apply returns the children of n selected by the segment
+func (s segment) apply(n node) []node {
+	res := []node{}
+	for _, c := range children(n) {
+		switch {
+		case s.wildcard:
+		case s.name != nil:
+			if c.parent == nil || c.key != *s.name {
+				continue
+			}
+		case s.index != nil:
+			if c.parent != nil || c.key != strconv.Itoa(*s.index) {
+				continue
+			}
+		case s.filter != nil:
+			if !s.filter.match(c.value) {
+				continue
+			}
+		}
+		res = append(res, c)
+	}
+	return res
+}
+
+// children returns the members of an object, sorted by key, or the items of an array
+func children(n node) []node {
+	res := []node{}
+	switch v := n.value.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			k := k
+			res = append(res, node{value: v[k], parent: v, key: k, set: func(nv interface{}) { v[k] = nv }})
+		}
+	case []interface{}:
+		for i := range v {
+			i := i
+			res = append(res, node{value: v[i], key: strconv.Itoa(i), set: func(nv interface{}) { v[i] = nv }})
+		}
+	}
+	return res
+}
+
+// descendants returns n and all nodes below it
+func descendants(n node) []node {
+	res := []node{n}
+	for _, c := range children(n) {
+		res = append(res, descendants(c)...)
+	}
+	return res
+}
+
+func (f *filterExpr) match(v interface{}) bool {
+	for _, s := range f.path {
+		found := false
+		for _, c := range children(node{value: v}) {
+			if (s.name != nil && c.parent != nil && c.key == *s.name) ||
+				(s.index != nil && c.parent == nil && c.key == strconv.Itoa(*s.index)) {
+				v, found = c.value, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	switch f.op {
+	case "==":
+		return equal(v, f.literal)
+	case "!=":
+		return !equal(v, f.literal)
+	}
+	return true
+}
+
+// equal compares scalars, numbers are compared by value
+func equal(a, b interface{}) bool {
+	if fa, ok := number(a); ok {
+		fb, ok := number(b)
+		return ok && fa == fb
+	}
+	return a == b
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// parser parses JSONPath expressions
+type parser struct {
+	s string
+}
+
+func (p *parser) consume(prefix string) bool {
+	if strings.HasPrefix(p.s, prefix) {
+		p.s = p.s[len(prefix):]
+		return true
+	}
+	return false
+}
+
+// segments parses segments until the end of the expression
+// relative paths of filters end at the first character that doesn't start a segment
+func (p *parser) segments(relative bool) ([]segment, error) {
+	res := []segment{}
+	for p.s != "" {
+		s := segment{}
+		switch {
+		case !relative && p.consume(".."):
+			s.recursive = true
+			if strings.HasPrefix(p.s, "[") {
+				break
+			}
+			if err := p.member(&s); err != nil {
+				return nil, err
+			}
+			res = append(res, s)
+			continue
+		case p.consume("."):
+			if err := p.member(&s); err != nil {
+				return nil, err
+			}
+			if relative && s.wildcard {
+				return nil, fmt.Errorf("wildcards aren't supported in filters")
+			}
+			res = append(res, s)
+			continue
+		case strings.HasPrefix(p.s, "["):
+		default:
+			if relative {
+				return res, nil
+			}
+			return nil, fmt.Errorf("unexpected %q", p.s)
+		}
+		if err := p.bracket(&s, relative); err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// member parses the name after a dot
+func (p *parser) member(s *segment) error {
+	if p.consume("*") {
+		s.wildcard = true
+		return nil
+	}
+	i := strings.IndexAny(p.s, ".[ =!)")
+	if i < 0 {
+		i = len(p.s)
+	}
+	if i == 0 {
+		return fmt.Errorf("missing name at %q", p.s)
+	}
+	name := p.s[:i]
+	p.s = p.s[i:]
+	s.name = &name
+	return nil
+}
+
+// bracket parses a bracketed selector
+func (p *parser) bracket(s *segment, relative bool) error {
+	p.consume("[")
+	switch {
+	case p.consume("*"):
+		s.wildcard = true
+	case strings.HasPrefix(p.s, "'") || strings.HasPrefix(p.s, `"`):
+		lit, err := p.literal()
+		if err != nil {
+			return err
+		}
+		name, ok := lit.(string)
+		if !ok {
+			return fmt.Errorf("invalid name %v", lit)
+		}
+		s.name = &name
+	case strings.HasPrefix(p.s, "?"):
+		if relative {
+			return fmt.Errorf("nested filters aren't supported")
+		}
+		f, err := p.filter()
+		if err != nil {
+			return err
+		}
+		s.filter = f
+	default:
+		i := strings.Index(p.s, "]")
+		if i < 0 {
+			return fmt.Errorf("missing ]")
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(p.s[:i]))
+		if err != nil {
+			return fmt.Errorf("invalid index %q", p.s[:i])
+		}
+		p.s = p.s[i:]
+		s.index = &n
+	}
+	if !p.consume("]") {
+		return fmt.Errorf("missing ] at %q", p.s)
+	}
+	return nil
+}
+
+// filter parses `?(@... op literal)` or `?@... op literal`
+func (p *parser) filter() (*filterExpr, error) {
+	p.consume("?")
+	p.s = strings.TrimLeft(p.s, " ")
+	parens := p.consume("(")
+	p.s = strings.TrimLeft(p.s, " ")
+	if !p.consume("@") {
+		return nil, fmt.Errorf("filter must start with @")
+	}
+	rel, err := p.segments(true)
+	if err != nil {
+		return nil, err
+	}
+	f := &filterExpr{path: rel}
+	p.s = strings.TrimLeft(p.s, " ")
+	for _, op := range []string{"==", "!="} {
+		if p.consume(op) {
+			f.op = op
+			p.s = strings.TrimLeft(p.s, " ")
+			if f.literal, err = p.literal(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	p.s = strings.TrimLeft(p.s, " ")
+	if parens && !p.consume(")") {
+		return nil, fmt.Errorf("missing ) at %q", p.s)
+	}
+	return f, nil
+}
+
+// literal parses a quoted string, number, boolean or null
+func (p *parser) literal() (interface{}, error) {
+	if p.s == "" {
+		return nil, fmt.Errorf("missing literal")
+	}
+	if q := p.s[0]; q == '\'' || q == '"' {
+		i := strings.IndexByte(p.s[1:], q)
+		if i < 0 {
+			return nil, fmt.Errorf("unterminated string %s", p.s)
+		}
+		lit := p.s[1 : i+1]
+		p.s = p.s[i+2:]
+		return lit, nil
+	}
+	i := strings.IndexAny(p.s, " )]")
+	if i < 0 {
+		i = len(p.s)
+	}
+	raw := p.s[:i]
+	p.s = p.s[i:]
+	switch raw {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid literal %q", raw)
+	}
+	return f, nil
+}
@@ -0,0 +1,76 @@
+package specs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const doc = `{
+  "paths": {
+    "/v1/projects": {
+      "get": {"tags": ["Projects"], "parameters": [{"name": "Authorization", "in": "header"}, {"name": "limit", "in": "query"}]},
+      "post": {"tags": ["Projects"], "parameters": [{"name": "limit", "in": "query", "schema": {"maximum": 100}}]}
+    },
+    "/v1/folders": {
+      "get": {"tags": ["Folders", "Private"]}
+    }
+  },
+  "components": {"schemas": {"Project": {"properties": {"createdAt": {"type": "string", "format": "date-time"}}}}}
+}`
+
+func find(t *testing.T, expr string) []interface{} {
+	d, err := Decode([]byte(doc))
+	require.NoError(t, err)
+	p, err := compile(expr)
+	require.NoError(t, err)
+	res := []interface{}{}
+	for _, n := range p.find(&d) {
+		res = append(res, n.value)
+	}
+	return res
+}
+
+func TestCompile_Find(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []interface{}
+	}{
+		{"$.paths['/v1/folders'].get.tags", []interface{}{[]interface{}{"Folders", "Private"}}},
+		{`$.paths["/v1/folders"].get.tags[1]`, []interface{}{"Private"}},
+		{"$.paths.*.*.tags[0]", []interface{}{"Folders", "Projects", "Projects"}},
+		{"$.paths.*[*].tags[0]", []interface{}{"Folders", "Projects", "Projects"}},
+		{"$.paths.*.get.parameters[?(@.name == 'Authorization')].in", []interface{}{"header"}},
+		{"$.paths.*.get.parameters[?@.name != 'Authorization'].name", []interface{}{"limit"}},
+		{"$..parameters[?(@.schema)].name", []interface{}{"limit"}},
+		{"$..parameters[?(@.schema.maximum == 100)].name", []interface{}{"limit"}},
+		{"$..[?(@.format == 'date-time')].type", []interface{}{"string"}},
+		{"$..tags[?(@ == 'Private')]", []interface{}{"Private"}},
+		{"$..format", []interface{}{"date-time"}},
+		{"$.paths.missing", []interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.Equal(t, tt.want, find(t, tt.expr))
+		})
+	}
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"paths",
+		"$.",
+		"$.paths[",
+		"$.paths[abc]",
+		"$.paths['/v1",
+		"$.paths[?(@.name == )]",
+		"$.paths[?(@.name == 'a']",
+		"$.paths[?(name == 'a')]",
+		"$.paths[?(@.*)]",
+		"$.paths x",
+	} {
+		_, err := compile(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,209 @@
+package specs
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overlay is an OpenAPI overlay, see https://spec.openapis.org/overlay/v1.0.0
+// it describes the changes applied to an upstream spec before the client is generated
+type Overlay struct {
+	Overlay string   `yaml:"overlay"`
+	Info    Info     `yaml:"info"`
+	Actions []Action `yaml:"actions"`
+}
+
+// Info describes the overlay
+type Info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// Action changes the nodes selected by Target
+// Update is merged into objects and appended to arrays, Remove removes the nodes
+// and the x-rename extension renames the selected object members
+type Action struct {
+	Target      string      `yaml:"target"`
+	Description string      `yaml:"description,omitempty"`
+	Update      interface{} `yaml:"update,omitempty"`
+	Remove      bool        `yaml:"remove,omitempty"`
+	Rename      string      `yaml:"x-rename,omitempty"`
+}
+
+// removed marks array items to remove
+type removed struct{}
+
+// LoadOverlay loads the overlay at the given path
+func LoadOverlay(path string) (*Overlay, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	o := &Overlay{}
+	if err := yaml.Unmarshal(b, o); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if o.Overlay == "" {
+		return nil, fmt.Errorf("%s: missing overlay version", path)
+	}
+	for i, a := range o.Actions {
+		if err := a.validate(); err != nil {
+			return nil, fmt.Errorf("%s: action %d: %w", path, i, err)
+		}
+	}
+	return o, nil
+}
+
+func (a Action) validate() error {
+	n := 0
+	for _, set := range []bool{a.Update != nil, a.Remove, a.Rename != ""} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("%s: exactly one of update, remove and x-rename must be set", a.Target)
+	}
+	_, err := compile(a.Target)
+	return err
+}
+
+// Apply applies the actions in order to doc
+// actions are idempotent, applying them to a patched spec doesn't change it
+func (o *Overlay) Apply(doc interface{}) (interface{}, error) {
+	for _, a := range o.Actions {
+		p, err := compile(a.Target)
+		if err != nil {
+			return nil, err
+		}
+		nodes := p.find(&doc)
+		switch {
+		case a.Remove:
+			for _, n := range nodes {
+				if n.parent != nil {
+					delete(n.parent, n.key)
+					continue
+				}
+				n.set(removed{})
+			}
+			doc = prune(doc)
+		case a.Rename != "":
+			for _, n := range nodes {
+				if n.parent == nil {
+					return nil, fmt.Errorf("%s: x-rename only applies to object members", a.Target)
+				}
+				if _, ok := n.parent[a.Rename]; ok {
+					return nil, fmt.Errorf("%s: %s already exists", a.Target, a.Rename)
+				}
+				delete(n.parent, n.key)
+				n.parent[a.Rename] = n.value
+			}
+		default:
+			for _, n := range nodes {
+				v, err := merge(n.value, normalize(a.Update))
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", a.Target, err)
+				}
+				n.set(v)
+			}
+		}
+	}
+	return doc, nil
+}
+
+// merge merges update into v
+// objects are merged recursively, items are appended to arrays unless present, other values are replaced
+func merge(v, update interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		u, ok := update.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("can't merge %T into an object", update)
+		}
+		for _, k := range sortedKeys(u) {
+			if _, ok := t[k].(map[string]interface{}); ok {
+				m, err := merge(t[k], u[k])
+				if err != nil {
+					return nil, err
+				}
+				t[k] = m
+				continue
+			}
+			t[k] = u[k]
+		}
+		return t, nil
+	case []interface{}:
+		items, ok := update.([]interface{})
+		if !ok {
+			items = []interface{}{update}
+		}
+		for _, item := range items {
+			if !contains(t, item) {
+				t = append(t, item)
+			}
+		}
+		return t, nil
+	}
+	return update, nil
+}
+
+func contains(items []interface{}, v interface{}) bool {
+	for _, item := range items {
+		if equalJSON(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// prune drops removed array items
+func prune(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = prune(t[k])
+		}
+	case []interface{}:
+		res := t[:0]
+		for _, item := range t {
+			if _, ok := item.(removed); !ok {
+				res = append(res, prune(item))
+			}
+		}
+		return res
+	}
+	return v
+}
+
+// normalize converts values decoded from YAML to the types used by encoding/json
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		for k, item := range t {
+			res[k] = normalize(item)
+		}
+		return res
+	case []interface{}:
+		res := []interface{}{}
+		for _, item := range t {
+			res = append(res, normalize(item))
+		}
+		return res
+	case int:
+		return float64(t)
+	}
+	return v
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
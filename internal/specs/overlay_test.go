@@ -0,0 +1,143 @@
+package specs
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeOverlay(t *testing.T, actions string) string {
+	p := filepath.Join(t.TempDir(), OverlayFile)
+	require.NoError(t, os.WriteFile(p, []byte("overlay: 1.0.0\ninfo:\n  title: test\n  version: 1.0.0\nactions:\n"+actions), 0o600))
+	return p
+}
+
+func apply(t *testing.T, actions string) string {
+	o, err := LoadOverlay(writeOverlay(t, actions))
+	require.NoError(t, err)
+	d, err := Decode([]byte(doc))
+	require.NoError(t, err)
+	d, err = o.Apply(d)
+	require.NoError(t, err)
+	b, err := Encode(d)
+	require.NoError(t, err)
+
+	// actions are idempotent
+	again, err := o.Apply(d)
+	require.NoError(t, err)
+	assert.Empty(t, Diff(d, again))
+	return string(b)
+}
+
+// at returns the value at the given keys
+func at(v interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		v = v.(map[string]interface{})[k]
+	}
+	return v
+}
+
+func TestOverlay_Apply(t *testing.T) {
+	t.Run("remove", func(t *testing.T) {
+		res := apply(t, `
+- target: $.paths..parameters[?(@.name == 'Authorization')]
+  remove: true
+- target: $..[?(@.format == 'date-time')].format
+  remove: true
+`)
+		assert.NotContains(t, res, "Authorization")
+		assert.NotContains(t, res, "date-time")
+		assert.Contains(t, res, `"name": "limit"`)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		res := apply(t, `
+- target: $.paths.*[?(@.tags[0] == 'Folders')]
+  update:
+    tags: [Instances]
+    summary: list folders
+- target: $.paths.*.post.parameters[0]
+  update:
+    schema:
+      minimum: 1
+- target: $.paths['/v1/projects'].get.tags
+  update: [Projects, Private]
+`)
+		d, err := Decode([]byte(res))
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"Instances"}, at(d, "paths", "/v1/folders", "get", "tags"))
+		assert.Equal(t, "list folders", at(d, "paths", "/v1/folders", "get", "summary"))
+		assert.Equal(t, []interface{}{"Projects", "Private"}, at(d, "paths", "/v1/projects", "get", "tags"))
+		post := at(d, "paths", "/v1/projects", "post", "parameters").([]interface{})
+		assert.Equal(t, map[string]interface{}{"maximum": json.Number("100"), "minimum": json.Number("1")}, post[0].(map[string]interface{})["schema"])
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		res := apply(t, `
+- target: $.components.schemas.Project
+  x-rename: ProjectSchema
+`)
+		assert.Contains(t, res, `"ProjectSchema"`)
+		assert.NotContains(t, res, `"Project"`)
+	})
+}
+
+func TestOverlay_ApplyErrors(t *testing.T) {
+	for name, actions := range map[string]string{
+		"rename conflict":     "- target: $.paths['/v1/folders']\n  x-rename: /v1/projects\n",
+		"rename array item":   "- target: $.paths.*.get.tags[0]\n  x-rename: Tag\n",
+		"merge into object":   "- target: $.components\n  update: [a]\n",
+		"no action":           "- target: $.components\n",
+		"several actions":     "- target: $.components\n  remove: true\n  x-rename: a\n",
+		"invalid target path": "- target: components\n  remove: true\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			o, err := LoadOverlay(writeOverlay(t, actions))
+			if err != nil {
+				return
+			}
+			d, err := Decode([]byte(doc))
+			require.NoError(t, err)
+			_, err = o.Apply(d)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestOverlay_ResourceManagement reverts the patches of the committed resource manager spec
+// and checks the overlay restores it
+func TestOverlay_ResourceManagement(t *testing.T) {
+	services, err := Services(filepath.Join("..", ".."))
+	require.NoError(t, err)
+	var rm Service
+	for _, s := range services {
+		if s.Name == "resource-management/v2.0" {
+			rm = s
+		}
+	}
+	require.NotEmpty(t, rm.Overlay)
+
+	b, err := os.ReadFile(rm.Spec)
+	require.NoError(t, err)
+	upstream := strings.NewReplacer(
+		"#/components/schemas/LimitSchema", "#/components/schemas/Limit",
+		`"LimitSchema":`, `"Limit":`,
+		`"enum": ["project"]`, `"enum": ["folder", "project"]`,
+		`"enum": [`+"\n"+`            "project"`, `"enum": ["folder",`+"\n"+`            "project"`,
+	).Replace(string(b))
+	require.NotEqual(t, string(b), upstream)
+	committed, err := rm.Committed()
+	require.NoError(t, err)
+	d, err := Decode([]byte(upstream))
+	require.NoError(t, err)
+	require.NotEmpty(t, Diff(committed, d))
+
+	patched, err := rm.Patch([]byte(upstream))
+	require.NoError(t, err)
+	assert.Empty(t, Diff(committed, patched))
+}
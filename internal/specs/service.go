@@ -0,0 +1,160 @@
+// Package specs maintains the OpenAPI specs the clients are generated from
+// upstream specs are patched with the overlay of each service before they're committed to internal/config
+package specs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OverlayFile is the overlay of a service, next to its generate.go
+	OverlayFile = "overlay.yaml"
+
+	// UpstreamFile optionally sets the URL of the upstream spec of a service
+	UpstreamFile = "upstream.yaml"
+)
+
+var generatePattern = regexp.MustCompile(`go:generate go run (\S+) -config \S+ (\S+\.json)`)
+
+// Service is a spec in internal/config
+type Service struct {
+	Name      string // service and version, i.e. kubernetes/v1.1
+	Dir       string // directory of the config
+	Spec      string // path to the committed spec
+	Generator string // generator and version used by the go:generate directive
+	Overlay   string // path to the overlay, empty if the upstream spec isn't patched
+	URL       string // upstream spec, empty if not set in upstream.yaml
+}
+
+// Services discovers the services in root/internal/config using their go:generate directives
+func Services(root string) ([]Service, error) {
+	files, err := filepath.Glob(filepath.Join(root, "internal", "config", "*", "*", "generate.go"))
+	if err != nil {
+		return nil, err
+	}
+	res := []Service{}
+	for _, f := range files {
+		dir := filepath.Dir(f)
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		m := generatePattern.FindSubmatch(b)
+		if m == nil {
+			return nil, fmt.Errorf("%s has no generate directive", f)
+		}
+		s := Service{
+			Name:      filepath.ToSlash(filepath.Join(filepath.Base(filepath.Dir(dir)), filepath.Base(dir))),
+			Dir:       dir,
+			Spec:      filepath.Join(dir, string(m[2])),
+			Generator: string(m[1]),
+		}
+		if _, err := os.Stat(filepath.Join(dir, OverlayFile)); err == nil {
+			s.Overlay = filepath.Join(dir, OverlayFile)
+		}
+		if b, err := os.ReadFile(filepath.Join(dir, UpstreamFile)); err == nil {
+			u := struct {
+				URL string `yaml:"url"`
+			}{}
+			if err := yaml.Unmarshal(b, &u); err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, UpstreamFile), err)
+			}
+			s.URL = u.URL
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// Upstream returns the location of the upstream spec
+// the URL of upstream.yaml is used if set, otherwise the spec is expected at from/<name>/<spec file>
+func (s Service) Upstream(from string) string {
+	if s.URL != "" || from == "" {
+		return s.URL
+	}
+	name := filepath.Base(s.Spec)
+	if strings.HasPrefix(from, "http://") || strings.HasPrefix(from, "https://") {
+		return strings.TrimSuffix(from, "/") + "/" + path.Join(s.Name, name)
+	}
+	return filepath.Join(from, filepath.FromSlash(s.Name), name)
+}
+
+// Patch applies the overlay of the service to an upstream spec
+func (s Service) Patch(upstream []byte) (interface{}, error) {
+	doc, err := Decode(upstream)
+	if err != nil {
+		return nil, fmt.Errorf("%s: parsing upstream spec: %w", s.Name, err)
+	}
+	if s.Overlay == "" {
+		return doc, nil
+	}
+	o, err := LoadOverlay(s.Overlay)
+	if err != nil {
+		return nil, err
+	}
+	return o.Apply(doc)
+}
+
+// Committed returns the committed spec
+func (s Service) Committed() (interface{}, error) {
+	b, err := os.ReadFile(s.Spec)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := Decode(b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", s.Spec, err)
+	}
+	return doc, nil
+}
+
+// Verify checks that the committed spec is patched
+// as actions are idempotent, applying the overlay to the committed spec mustn't change it
+func (s Service) Verify() error {
+	b, err := os.ReadFile(s.Spec)
+	if err != nil {
+		return err
+	}
+	committed, err := s.Committed()
+	if err != nil {
+		return err
+	}
+	patched, err := s.Patch(b)
+	if err != nil {
+		return err
+	}
+	if changes := Diff(committed, patched); len(changes) > 0 {
+		return fmt.Errorf("%s: the overlay changes the committed spec at %s", s.Name, changes[0].Path)
+	}
+	return nil
+}
+
+// Fetch returns the spec at location, an http(s) URL or a file path
+func Fetch(ctx context.Context, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.ReadFile(location)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", location, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
@@ -0,0 +1,87 @@
+package specs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func services(t *testing.T) []Service {
+	res, err := Services(filepath.Join("..", ".."))
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+	return res
+}
+
+func TestServices(t *testing.T) {
+	for _, s := range services(t) {
+		assert.FileExists(t, s.Spec)
+		assert.True(t, strings.HasPrefix(s.Generator, "github.com/do87/stackit-client-generator/cmd/oapi-codegen@"), s.Generator)
+	}
+}
+
+// TestServices_Verify makes sure the committed specs are patched by their overlays
+func TestServices_Verify(t *testing.T) {
+	for _, s := range services(t) {
+		t.Run(s.Name, func(t *testing.T) {
+			assert.NoError(t, s.Verify(), "run `make update-specs`")
+		})
+	}
+}
+
+func TestService_Upstream(t *testing.T) {
+	s := Service{Name: "kubernetes/v1.1", Spec: filepath.Join("config", "kubernetes", "v1.1", "kubernetes.json")}
+	assert.Equal(t, "", s.Upstream(""))
+	assert.Equal(t, "https://example.com/specs/kubernetes/v1.1/kubernetes.json", s.Upstream("https://example.com/specs/"))
+	assert.Equal(t, filepath.Join("specs", "kubernetes", "v1.1", "kubernetes.json"), s.Upstream("specs"))
+
+	s.URL = "https://example.com/ske.json"
+	assert.Equal(t, s.URL, s.Upstream("specs"))
+}
+
+func TestFetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/spec.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(doc))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	b, err := Fetch(ctx, srv.URL+"/spec.json")
+	require.NoError(t, err)
+	assert.Equal(t, doc, string(b))
+	_, err = Fetch(ctx, srv.URL+"/missing.json")
+	assert.EqualError(t, err, "fetching "+srv.URL+"/missing.json: 404 Not Found")
+
+	p := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, os.WriteFile(p, []byte(doc), 0o600))
+	b, err = Fetch(ctx, p)
+	require.NoError(t, err)
+	assert.Equal(t, doc, string(b))
+}
+
+func TestDiff(t *testing.T) {
+	a, err := Decode([]byte(`{"paths": {"/a": {"get": {}}, "/b~c": {}}, "tags": ["a", "b"], "info": {"version": 1}}`))
+	require.NoError(t, err)
+	b, err := Decode([]byte(`{"paths": {"/a": {"get": {}, "post": {"tags": ["x"]}}}, "tags": ["a"], "info": {"version": 2}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Change{
+		{"~", "/info/version"},
+		{"+", "/paths/~1a/post"},
+		{"-", "/paths/~1b~0c"},
+		{"-", "/tags/1"},
+	}, Diff(a, b))
+	assert.Empty(t, Diff(a, a))
+	assert.Equal(t, "~ /", Diff("a", "b")[0].String())
+}
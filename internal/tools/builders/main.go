@@ -0,0 +1,410 @@
+// builders generates fluent builders for the request models listed in the builders.yaml of a service
+// a builder starts with the defaults of the spec and of builders.yaml, and validates the model when it's built
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/internal/contract"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	root   = "."
+	config = "builders.yaml"
+	output = "builders.go"
+	suffix = "JSONRequestBody"
+)
+
+type node = map[string]interface{}
+
+// Config is the builders.yaml of a service
+type Config struct {
+	Builders []Builder `yaml:"builders"`
+}
+
+// Builder describes the builder of a model
+type Builder struct {
+	Package  string    `yaml:"package"`  // directory of the package, relative to the generated package of the service
+	Type     string    `yaml:"type"`     // model to build
+	Name     string    `yaml:"name"`     // name of the builder, defaults to the type
+	Skip     []string  `yaml:"skip"`     // fields without a setter, i.e. read only fields
+	Defaults []Default `yaml:"defaults"` // defaults in addition to the ones of the spec
+}
+
+// Default is the default of a field
+type Default struct {
+	Field  string `yaml:"field"`
+	Value  string `yaml:"value"` // Go expression in the scope of the package
+	Doc    string `yaml:"doc"`
+	Unless string `yaml:"unless"` // field that prevents the default if set, the default is then applied by Build
+}
+
+func main() {
+	builders, err := generateAll(root)
+	if err == nil {
+		for p, src := range builders {
+			if err = os.WriteFile(p, src, 0o644); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate builders: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("generated builders of %d packages\n", len(builders))
+}
+
+// generateAll generates the builders of all services under root with a builders.yaml
+// it returns the source of each builders.go by its path
+func generateAll(root string) (map[string][]byte, error) {
+	targets, err := contract.Targets(root)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][]byte{}
+	for _, t := range targets {
+		b, err := os.ReadFile(filepath.Join(filepath.Dir(t.Spec), config))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		cfg := Config{}
+		if err := yaml.Unmarshal(b, &cfg); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Name, err)
+		}
+		spec, err := contract.Load(t.Spec)
+		if err != nil {
+			return nil, err
+		}
+		pkgs := map[string][]Builder{}
+		for _, b := range cfg.Builders {
+			dir := filepath.Join(t.Package, b.Package)
+			pkgs[dir] = append(pkgs[dir], b)
+		}
+		for dir, builders := range pkgs {
+			src, err := generate(dir, spec, builders)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", dir, err)
+			}
+			res[filepath.Join(dir, output)] = src
+		}
+	}
+	return res, nil
+}
+
+// generate returns the builders of the package in dir
+func generate(dir string, spec *contract.Spec, builders []Builder) ([]byte, error) {
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	reqs, err := contract.ParseRequests(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	for _, builder := range builders {
+		st, ok := pkg.types[builder.Type].(*ast.StructType)
+		if !ok {
+			return nil, fmt.Errorf("%s isn't a struct", builder.Type)
+		}
+		if !pkg.validated[builder.Type] {
+			return nil, fmt.Errorf("%s has no Validate method, run `make validators`", builder.Type)
+		}
+		defaults, err := defaults(builder, st, pkg.body(spec, reqs, builder.Type))
+		if err != nil {
+			return nil, err
+		}
+		if err := render(b, pkg, builder, st, defaults); err != nil {
+			return nil, err
+		}
+	}
+	head := &bytes.Buffer{}
+	fmt.Fprintf(head, "// Code generated by internal/tools/builders. DO NOT EDIT.\n\npackage %s\n", pkg.name)
+	// ptr is only used by some of the defaults
+	if strings.Contains(b.String(), "ptr.Of") {
+		head.WriteString("\nimport \"github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/ptr\"\n")
+	}
+	head.Write(b.Bytes())
+	return format.Source(head.Bytes())
+}
+
+// defaults returns the defaults of the builder, the ones of builders.yaml take precedence over the spec
+func defaults(builder Builder, st *ast.StructType, schema node) ([]Default, error) {
+	fields := map[string]bool{}
+	res := []Default{}
+	for _, d := range builder.Defaults {
+		fields[d.Field] = true
+	}
+	props, _ := schema["properties"].(node)
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 || fields[f.Names[0].Name] {
+			continue
+		}
+		prop, _ := props[jsonName(f)].(node)
+		v, ok := prop["default"]
+		if !ok || prop["readOnly"] == true {
+			continue
+		}
+		value, ok := literal(f.Type, v)
+		if !ok {
+			continue
+		}
+		doc := fmt.Sprint(v)
+		if s, ok := v.(string); ok {
+			doc = strconv.Quote(s)
+		}
+		res = append(res, Default{Field: f.Names[0].Name, Value: value, Doc: doc + " as in the spec"})
+	}
+	for _, d := range builder.Defaults {
+		f := field(st, d.Field)
+		if f == nil {
+			return nil, fmt.Errorf("%s has no field %s", builder.Type, d.Field)
+		}
+		if d.Unless != "" {
+			u := field(st, d.Unless)
+			if u == nil {
+				return nil, fmt.Errorf("%s has no field %s", builder.Type, d.Unless)
+			}
+			if !nillable(f.Type) || !nillable(u.Type) {
+				return nil, fmt.Errorf("%s: %s and %s must be pointers, slices or maps to be checked for nil", builder.Type, d.Field, d.Unless)
+			}
+		}
+		res = append(res, d)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return index(st, res[i].Field) < index(st, res[j].Field)
+	})
+	return res, nil
+}
+
+// render writes the builder of a model
+func render(b *bytes.Buffer, pkg *goPackage, builder Builder, st *ast.StructType, defaults []Default) error {
+	name := builder.Name
+	if name == "" {
+		name = builder.Type
+	}
+	typ := name + "Builder"
+	skip := map[string]bool{}
+	for _, s := range builder.Skip {
+		if field(st, s) == nil {
+			return fmt.Errorf("%s has no field %s", builder.Type, s)
+		}
+		skip[s] = true
+	}
+
+	fmt.Fprintf(b, "\n// %s builds a %s\n", typ, builder.Type)
+	fmt.Fprintf(b, "type %s struct {\n\tv %s\n}\n", typ, builder.Type)
+
+	fmt.Fprintf(b, "\n// New%s returns a builder of a %s", typ, builder.Type)
+	if len(defaults) == 0 {
+		b.WriteString("\n")
+	} else {
+		b.WriteString(" with the following defaults:\n")
+		for _, d := range defaults {
+			fmt.Fprintf(b, "//   - %s: %s\n", d.Field, d.Doc)
+		}
+	}
+	fmt.Fprintf(b, "func New%s() *%s {\n\tb := &%s{}\n", typ, typ, typ)
+	for _, d := range defaults {
+		if d.Unless == "" {
+			fmt.Fprintf(b, "\tb.v.%s = %s\n", d.Field, d.Value)
+		}
+	}
+	b.WriteString("\treturn b\n}\n")
+
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 || skip[f.Names[0].Name] {
+			continue
+		}
+		fn := f.Names[0].Name
+		if fn == "Build" {
+			return fmt.Errorf("%s has a field Build, which clashes with the Build method", builder.Type)
+		}
+		param, value := "v "+pkg.print(f.Type), "v"
+		t := f.Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t, value = star.X, "&v"
+			param = "v " + pkg.print(t)
+		}
+		if arr, ok := t.(*ast.ArrayType); ok && arr.Len == nil {
+			param = "v ..." + pkg.print(arr.Elt)
+		}
+		fmt.Fprintf(b, "\n// %s sets the %s of the %s\n", fn, fn, builder.Type)
+		fmt.Fprintf(b, "func (b *%s) %s(%s) *%s {\n\tb.v.%s = %s\n\treturn b\n}\n", typ, fn, param, typ, fn, value)
+	}
+
+	conditional := []Default{}
+	for _, d := range defaults {
+		if d.Unless != "" {
+			conditional = append(conditional, d)
+		}
+	}
+	fmt.Fprintf(b, "\n// Build returns the %s, and the errors of its Validate method\n", builder.Type)
+	if len(conditional) == 0 {
+		fmt.Fprintf(b, "func (b *%s) Build() (%s, error) {\n\treturn b.v, b.v.Validate()\n}\n", typ, builder.Type)
+		return nil
+	}
+	b.WriteString("// defaults depending on other fields are applied to the returned copy only\n")
+	fmt.Fprintf(b, "func (b *%s) Build() (%s, error) {\n\tv := b.v\n", typ, builder.Type)
+	for _, d := range conditional {
+		fmt.Fprintf(b, "\tif v.%s == nil && v.%s == nil {\n\t\tv.%s = %s\n\t}\n", d.Field, d.Unless, d.Field, d.Value)
+	}
+	b.WriteString("\treturn v, v.Validate()\n}\n")
+	return nil
+}
+
+// goPackage are the type declarations of a package
+type goPackage struct {
+	name      string
+	fset      *token.FileSet
+	types     map[string]ast.Expr // type expression by type name
+	validated map[string]bool     // types with a Validate method
+}
+
+// parsePackage parses the type declarations of the package in dir
+func parsePackage(dir string) (*goPackage, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	pkg := &goPackage{fset: token.NewFileSet(), types: map[string]ast.Expr{}, validated: map[string]bool{}}
+	for _, file := range files {
+		base := filepath.Base(file)
+		if base == output || base == "fake.go" || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(pkg.fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		pkg.name = f.Name.Name
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					pkg.types[ts.Name.Name] = ts.Type
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || d.Name.Name != "Validate" {
+					continue
+				}
+				if id, ok := d.Recv.List[0].Type.(*ast.Ident); ok {
+					pkg.validated[id.Name] = true
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// body returns the schema of the request body of type name, or nil if it isn't a request body
+func (p *goPackage) body(spec *contract.Spec, reqs []contract.Request, name string) node {
+	for alias, t := range p.types {
+		id, ok := t.(*ast.Ident)
+		if !ok || id.Name != name || !strings.HasSuffix(alias, suffix) {
+			continue
+		}
+		fn := "New" + strings.TrimSuffix(alias, suffix) + "RequestWithBody"
+		for _, r := range reqs {
+			if r.Func != fn {
+				continue
+			}
+			if o := spec.Find(r.Method, r.Path); o != nil {
+				return o.Body
+			}
+		}
+	}
+	return nil
+}
+
+// print returns the source of the type expression t
+func (p *goPackage) print(t ast.Expr) string {
+	b := &bytes.Buffer{}
+	_ = printer.Fprint(b, p.fset, t)
+	return b.String()
+}
+
+// literal returns v as Go expression of type t, if t is a basic type or a pointer to one
+func literal(t ast.Expr, v interface{}) (string, bool) {
+	pointer := false
+	if star, ok := t.(*ast.StarExpr); ok {
+		t, pointer = star.X, true
+	}
+	id, ok := t.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	var lit string
+	switch v := v.(type) {
+	case string:
+		lit = strconv.Quote(v)
+	case bool:
+		lit = strconv.FormatBool(v)
+	case float64:
+		lit = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return "", false
+	}
+	if pointer {
+		return "ptr.Of[" + id.Name + "](" + lit + ")", true
+	}
+	return lit, true
+}
+
+// nillable reports whether a field of type t can be compared to nil
+func nillable(t ast.Expr) bool {
+	switch t := t.(type) {
+	case *ast.StarExpr, *ast.MapType:
+		return true
+	case *ast.ArrayType:
+		return t.Len == nil
+	}
+	return false
+}
+
+func jsonName(f *ast.Field) string {
+	if f.Tag == nil {
+		return ""
+	}
+	tag, _ := strconv.Unquote(f.Tag.Value)
+	return strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+}
+
+func field(st *ast.StructType, name string) *ast.Field {
+	if i := index(st, name); i >= 0 {
+		return st.Fields.List[i]
+	}
+	return nil
+}
+
+func index(st *ast.StructType, name string) int {
+	for i, f := range st.Fields.List {
+		if len(f.Names) > 0 && f.Names[0].Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/ptr"
+	scrapeconfig "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/scrape-config"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildersUpToDate(t *testing.T) {
+	builders, err := generateAll(filepath.Join("..", "..", ".."))
+	require.NoError(t, err)
+	require.NotEmpty(t, builders)
+
+	for p, src := range builders {
+		b, err := os.ReadFile(p)
+		if assert.NoError(t, err, "run `make builders`") {
+			assert.Equal(t, string(src), string(b), "%s is outdated, run `make builders`", p)
+		}
+	}
+}
+
+func TestBuilders_Cluster(t *testing.T) {
+	pool, err := cluster.NewNodepoolBuilder().
+		Name("pool").
+		AvailabilityZones("eu01-1", "eu01-2").
+		Machine(cluster.Machine{Type: "c1.2", Image: cluster.Image{Version: "3510.2.2"}}).
+		Maximum(3).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu01-1", "eu01-2"}, pool.AvailabilityZones)
+	assert.Equal(t, 1, pool.Minimum)
+	assert.Equal(t, 3, pool.Maximum)
+	assert.Equal(t, 20, pool.Volume.Size)
+	assert.Equal(t, cluster.CONTAINERD, *pool.CRI.Name)
+
+	body, err := cluster.NewClusterBuilder().
+		Kubernetes(cluster.Kubernetes{Version: "1.25.5"}).
+		Nodepools(pool).
+		Build()
+	require.NoError(t, err)
+	assert.True(t, *body.Maintenance.AutoUpdate.KubernetesVersion)
+	assert.Len(t, body.Nodepools, 1)
+
+	_, err = cluster.NewClusterBuilder().Kubernetes(cluster.Kubernetes{Version: "1.25.5"}).Build()
+	var fe validate.FieldErrors
+	require.ErrorAs(t, err, &fe)
+	assert.Equal(t, "nodepools", fe[0].Field)
+}
+
+func TestBuilders_Defaults(t *testing.T) {
+	lb, err := instances.NewLoadBalancerBuilder().Name("my-lb").Build()
+	require.NoError(t, err)
+	assert.True(t, *lb.Options.EphemeralAddress)
+	assert.Equal(t, "my-lb", *lb.Name)
+
+	_, err = instances.NewLoadBalancerBuilder().Name("my_lb").Build()
+	assert.Error(t, err)
+
+	b := instances.NewLoadBalancerBuilder().Name("my-lb").ExternalAddress("192.0.2.1")
+	lb, err = b.Build()
+	require.NoError(t, err)
+	assert.Nil(t, lb.Options, "an ephemeral address shouldn't be requested with an external address")
+	assert.Equal(t, "192.0.2.1", *lb.ExternalAddress)
+
+	lb, err = b.Options(instances.LoadBalancerOptions{PrivateNetworkOnly: ptr.Of(true)}).Build()
+	require.NoError(t, err)
+	assert.Nil(t, lb.Options.EphemeralAddress, "set options shouldn't be replaced by the default")
+
+	sc, err := scrapeconfig.NewScrapeConfigBuilder().JobName("job").Build()
+	assert.EqualError(t, err, "staticConfigs: is required")
+	assert.Equal(t, "/metrics", *sc.MetricsPath)
+	assert.False(t, *sc.HonorLabels)
+	assert.Equal(t, scrapeconfig.CREATE_JSON_BODY_SCHEME_HTTPS, sc.Scheme)
+	assert.Equal(t, "5m", sc.ScrapeInterval)
+
+	i, err := instance.NewInstanceBuilder().Name("db").Build()
+	require.NoError(t, err)
+	assert.Equal(t, 1, *i.Replicas)
+	assert.Equal(t, "0 0 * * *", *i.BackupSchedule)
+}
@@ -0,0 +1,181 @@
+// enums generates helpers for the enums of every service package
+// an enum is a string type with constants of that type, the helpers are written to enums.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	root   = "pkg/services"
+	output = "enums.go"
+)
+
+// enum is a string type and its constants in declaration order
+type enum struct {
+	name   string
+	consts []string
+}
+
+func main() {
+	enums, err := generateAll(root)
+	if err == nil {
+		for p, src := range enums {
+			if err = os.WriteFile(p, src, 0o644); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate enums: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("generated enums of %d packages\n", len(enums))
+}
+
+// generateAll generates the enums of all packages in dir
+// it returns the source of each enums.go by its path
+func generateAll(dir string) (map[string][]byte, error) {
+	res := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		src, err := generate(p)
+		if src != nil {
+			res[filepath.Join(p, output)] = src
+		}
+		return err
+	})
+	return res, err
+}
+
+// generate returns the enums of the package in dir
+// it returns nil if the package has no enums
+func generate(dir string) ([]byte, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fset := token.NewFileSet()
+	pkg := ""
+	types := map[string]bool{}
+	decls := []*ast.GenDecl{}
+	for _, file := range files {
+		base := filepath.Base(file)
+		if base == output || base == "fake.go" || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			switch gen.Tok {
+			case token.TYPE:
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if id, ok := ts.Type.(*ast.Ident); ok && id.Name == "string" && !ts.Assign.IsValid() {
+						types[ts.Name.Name] = true
+					}
+				}
+			case token.CONST:
+				decls = append(decls, gen)
+			}
+		}
+	}
+
+	enums := map[string]*enum{}
+	values := map[string]map[string]bool{}
+	for _, gen := range decls {
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			typ, ok := vs.Type.(*ast.Ident)
+			if !ok || !types[typ.Name] || len(vs.Names) != len(vs.Values) {
+				continue
+			}
+			e, ok := enums[typ.Name]
+			if !ok {
+				e = &enum{name: typ.Name}
+				enums[typ.Name] = e
+				values[typ.Name] = map[string]bool{}
+			}
+			for i, name := range vs.Names {
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return nil, fmt.Errorf("%s: %s isn't a string literal", dir, name.Name)
+				}
+				v, _ := strconv.Unquote(lit.Value)
+				// constants with the same value are aliases
+				if values[typ.Name][v] {
+					continue
+				}
+				values[typ.Name][v] = true
+				e.consts = append(e.consts, name.Name)
+			}
+		}
+	}
+	if len(enums) == 0 {
+		return nil, nil
+	}
+
+	names := []string{}
+	for n := range enums {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	list := []*enum{}
+	for _, n := range names {
+		list = append(list, enums[n])
+	}
+	src, err := render(pkg, list)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	return src, nil
+}
+
+func render(pkg string, enums []*enum) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by internal/tools/enums. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	b.WriteString("import \"github.com/SchwarzIT/community-stackit-go-client/pkg/validate\"\n")
+	for _, e := range enums {
+		n := e.name
+		fmt.Fprintf(b, "\n// Values returns the values of %s\n", n)
+		fmt.Fprintf(b, "func (%s) Values() []%s {\n\treturn []%s{\n", n, n, n)
+		for _, c := range e.consts {
+			fmt.Fprintf(b, "\t\t%s,\n", c)
+		}
+		b.WriteString("\t}\n}\n")
+
+		fmt.Fprintf(b, "\n// IsValid reports whether e is one of the values of %s\n", n)
+		fmt.Fprintf(b, "func (e %s) IsValid() bool {\n\tswitch e {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n", n, strings.Join(e.consts, ", "))
+
+		fmt.Fprintf(b, "\n// String returns e as string\nfunc (e %s) String() string {\n\treturn string(e)\n}\n", n)
+
+		fmt.Fprintf(b, "\n// Parse%s returns s as %s, or a *validate.EnumError if s isn't one of its values\n", n, n)
+		fmt.Fprintf(b, "func Parse%s(s string) (%s, error) {\n\treturn validate.ParseEnum[%s](s)\n}\n", n, n, n)
+
+		fmt.Fprintf(b, "\n// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set\n")
+		fmt.Fprintf(b, "func (e *%s) UnmarshalJSON(b []byte) error {\n\treturn validate.UnmarshalEnum(b, e)\n}\n", n)
+	}
+	return format.Source(b.Bytes())
+}
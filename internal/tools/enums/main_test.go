@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumsUpToDate(t *testing.T) {
+	enums, err := generateAll(filepath.Join("..", "..", "..", root))
+	require.NoError(t, err)
+	require.NotEmpty(t, enums)
+
+	for p, src := range enums {
+		b, err := os.ReadFile(p)
+		if assert.NoError(t, err, "run `make enums`") {
+			assert.Equal(t, string(src), string(b), "%s is outdated, run `make enums`", p)
+		}
+	}
+}
+
+func TestEnums(t *testing.T) {
+	assert.Contains(t, cluster.TaintEffect("").Values(), cluster.NO_SCHEDULE)
+	assert.True(t, cluster.STATE_HEALTHY.IsValid())
+	assert.False(t, cluster.ClusterStatusState("STATE_UNKNOWN").IsValid())
+	assert.Equal(t, "STATE_HEALTHY", cluster.STATE_HEALTHY.String())
+
+	s, err := instance.ParseStatus("Ready")
+	require.NoError(t, err)
+	assert.Equal(t, instance.STATUS_READY, s)
+	_, err = instance.ParseStatus("READY")
+	assert.EqualError(t, err, `"READY" isn't a valid instance.Status, valid values are: Ready, Failure, Progressing, Deleted`)
+
+	defer validate.SetStrictEnums(false)
+	c := cluster.Cluster{}
+	body := []byte(`{"status": {"aggregated": "STATE_UNKNOWN"}}`)
+	require.NoError(t, json.Unmarshal(body, &c))
+	assert.Equal(t, cluster.ClusterStatusState("STATE_UNKNOWN"), *c.Status.Aggregated)
+
+	validate.SetStrictEnums(true)
+	var ee *validate.EnumError
+	assert.ErrorAs(t, json.Unmarshal(body, &c), &ee)
+}
@@ -0,0 +1,258 @@
+// fakes generates a fake of ClientWithResponsesInterface in every generated service package
+// the fakes are written to fake.go next to the interface
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	root          = "pkg/services"
+	output        = "fake.go"
+	interfaceName = "ClientWithResponsesInterface"
+)
+
+// method is a method of the interface
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func main() {
+	fakes, err := generateAll(root)
+	if err == nil {
+		for p, src := range fakes {
+			if err = os.WriteFile(p, src, 0o644); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate fakes: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("generated %d fakes\n", len(fakes))
+}
+
+// generateAll generates the fakes of all packages in dir
+// it returns the source of each fake by its path
+func generateAll(dir string) (map[string][]byte, error) {
+	res := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(p, ".go") || filepath.Base(p) == output || strings.HasSuffix(p, "_test.go") {
+			return err
+		}
+		src, err := generate(p)
+		if src != nil {
+			res[filepath.Join(filepath.Dir(p), output)] = src
+		}
+		return err
+	})
+	return res, err
+}
+
+// generate returns the fake for the interface declared in file
+// it returns nil if file doesn't declare the interface
+func generate(file string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	iface := findInterface(f)
+	if iface == nil {
+		return nil, nil
+	}
+
+	used := map[string]bool{}
+	methods := []method{}
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s: %s embeds other interfaces", file, interfaceName)
+		}
+		ast.Inspect(fn, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+		m, err := newMethod(fset, field.Names[0].Name, fn)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		methods = append(methods, m)
+	}
+
+	src, err := render(f.Name.Name, imports(f, used), methods)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return src, nil
+}
+
+func findInterface(f *ast.File) *ast.InterfaceType {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == interfaceName {
+				return it
+			}
+		}
+	}
+	return nil
+}
+
+func newMethod(fset *token.FileSet, name string, fn *ast.FuncType) (method, error) {
+	m := method{name: name}
+	for i, field := range fn.Params.List {
+		typ := field.Type
+		variadic := false
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = e.Elt, true
+		}
+		s, err := printNode(fset, typ)
+		if err != nil {
+			return m, err
+		}
+		names := []string{}
+		for _, n := range field.Names {
+			names = append(names, n.Name)
+		}
+		if len(names) == 0 {
+			names = append(names, fmt.Sprintf("p%d", i))
+		}
+		for _, n := range names {
+			m.params = append(m.params, param{name: n, typ: s, variadic: variadic})
+		}
+	}
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			s, err := printNode(fset, field.Type)
+			if err != nil {
+				return m, err
+			}
+			m.results = append(m.results, s)
+		}
+	}
+	if len(m.results) == 0 || m.results[len(m.results)-1] != "error" {
+		return m, fmt.Errorf("%s doesn't return an error", name)
+	}
+	return m, nil
+}
+
+// imports returns the imports of f used by the interface
+func imports(f *ast.File, used map[string]bool) []string {
+	res := []string{`"fmt"`}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if !used[name] || p == "fmt" {
+			continue
+		}
+		if spec.Name != nil {
+			res = append(res, spec.Name.Name+" "+spec.Path.Value)
+			continue
+		}
+		res = append(res, spec.Path.Value)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func render(pkg string, imports []string, methods []method) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by internal/tools/fakes. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	for _, i := range imports {
+		fmt.Fprintf(b, "\t%s\n", i)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// Fake is a fake " + interfaceName + " for tests\n")
+	b.WriteString("// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get\n")
+	b.WriteString("// and returns an error if the field isn't set\n")
+	b.WriteString("type Fake struct {\n")
+	for _, m := range methods {
+		fmt.Fprintf(b, "\t%sFunc func(%s) (%s)\n", m.name, m.signature(), strings.Join(m.results, ", "))
+	}
+	b.WriteString("}\n\nvar _ " + interfaceName + " = &Fake{}\n")
+	for _, m := range methods {
+		zero := []string{}
+		for _, r := range m.results[:len(m.results)-1] {
+			zero = append(zero, zeroValue(r))
+		}
+		zero = append(zero, fmt.Sprintf("notFaked(%q)", m.name))
+		fmt.Fprintf(b, "\n// %s calls %sFunc\n", m.name, m.name)
+		fmt.Fprintf(b, "func (f *Fake) %s(%s) (%s) {\n", m.name, m.signature(), strings.Join(m.results, ", "))
+		fmt.Fprintf(b, "\tif f.%sFunc == nil {\n\t\treturn %s\n\t}\n", m.name, strings.Join(zero, ", "))
+		fmt.Fprintf(b, "\treturn f.%sFunc(%s)\n}\n", m.name, m.args())
+	}
+	b.WriteString("\nfunc notFaked(method string) error {\n\treturn fmt.Errorf(\"fake: %s isn't implemented\", method)\n}\n")
+	return format.Source(b.Bytes())
+}
+
+func (m method) signature() string {
+	res := []string{}
+	for _, p := range m.params {
+		if p.variadic {
+			res = append(res, p.name+" ..."+p.typ)
+			continue
+		}
+		res = append(res, p.name+" "+p.typ)
+	}
+	return strings.Join(res, ", ")
+}
+
+func (m method) args() string {
+	res := []string{}
+	for _, p := range m.params {
+		if p.variadic {
+			res = append(res, p.name+"...")
+			continue
+		}
+		res = append(res, p.name)
+	}
+	return strings.Join(res, ", ")
+}
+
+func zeroValue(typ string) string {
+	if strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") {
+		return "nil"
+	}
+	return "*new(" + typ + ")"
+}
+
+func printNode(fset *token.FileSet, n ast.Node) (string, error) {
+	b := &bytes.Buffer{}
+	if err := format.Node(b, fset, n); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
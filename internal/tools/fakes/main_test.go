@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakesUpToDate(t *testing.T) {
+	fakes, err := generateAll(filepath.Join("..", "..", "..", root))
+	require.NoError(t, err)
+	require.NotEmpty(t, fakes)
+
+	for p, src := range fakes {
+		b, err := os.ReadFile(p)
+		if assert.NoError(t, err, "run `make fakes`") {
+			assert.Equal(t, string(src), string(b), "%s is outdated, run `make fakes`", p)
+		}
+	}
+}
+
+func TestFake(t *testing.T) {
+	healthy := cluster.STATE_HEALTHY
+	fake := &cluster.Fake{
+		GetFunc: func(ctx context.Context, projectID, clusterName string, reqEditors ...cluster.RequestEditorFn) (*cluster.GetResponse, error) {
+			return &cluster.GetResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				JSON200:      &cluster.Cluster{Name: &clusterName, Status: &cluster.ClusterStatus{Aggregated: &healthy}},
+			}, nil
+		},
+	}
+	c := &services.Services{Kubernetes: &kubernetes.Service{Cluster: fake}}
+
+	name, created, err := cluster.EnsureExists(context.Background(), c.Kubernetes.Cluster, "project", "my-cluster", cluster.CreateOrUpdateJSONRequestBody{})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, "my-cluster", name)
+
+	_, err = c.Kubernetes.Cluster.Delete(context.Background(), "project", "my-cluster")
+	assert.EqualError(t, err, "fake: Delete isn't implemented")
+}
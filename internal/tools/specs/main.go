@@ -0,0 +1,151 @@
+// specs maintains the OpenAPI specs in internal/config
+//
+//	specs verify               checks the committed specs are patched by their overlays
+//	specs check  [-from base]  reports how the upstream specs differ from the committed ones
+//	specs update [-from base]  replaces the committed specs with the patched upstream specs
+//
+// upstream specs are read from the url of each upstream.yaml, or from <base>/<service>/<version>/<spec file>
+// base is a URL or a directory, i.e. a checkout of the published specs
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/internal/specs"
+)
+
+const maxChanges = 20
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: specs verify|check|update [-from base] [service...]")
+		os.Exit(2)
+	}
+	fs := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	from := fs.String("from", os.Getenv("STACKIT_SPECS"), "URL or directory of the upstream specs")
+	_ = fs.Parse(os.Args[2:])
+
+	services, err := specs.Services(".")
+	if err == nil {
+		services = filter(services, fs.Args())
+		switch os.Args[1] {
+		case "verify":
+			err = verify(services)
+		case "check":
+			err = check(services, *from, false)
+		case "update":
+			err = check(services, *from, true)
+		default:
+			err = fmt.Errorf("unknown command %s", os.Args[1])
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// filter returns the services with the given names, all if there are none
+func filter(services []specs.Service, names []string) []specs.Service {
+	if len(names) == 0 {
+		return services
+	}
+	res := []specs.Service{}
+	for _, s := range services {
+		for _, n := range names {
+			if s.Name == n {
+				res = append(res, s)
+			}
+		}
+	}
+	return res
+}
+
+func verify(services []specs.Service) error {
+	failed := 0
+	for _, s := range services {
+		if err := s.Verify(); err != nil {
+			fmt.Println(err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d specs aren't patched by their overlay", failed, len(services))
+	}
+	fmt.Printf("%d specs verified\n", len(services))
+	return nil
+}
+
+// check compares the patched upstream specs with the committed ones
+// and writes the patched specs if update is set
+func check(services []specs.Service, from string, update bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	outdated, failed := 0, 0
+	for _, s := range services {
+		location := s.Upstream(from)
+		if location == "" {
+			fmt.Printf("%s: skipped, no upstream url or -from\n", s.Name)
+			continue
+		}
+		changes, patched, err := diff(ctx, s, location)
+		if err != nil {
+			fmt.Printf("%s: %s\n", s.Name, err)
+			failed++
+			continue
+		}
+		if len(changes) == 0 {
+			fmt.Printf("%s: up to date\n", s.Name)
+			continue
+		}
+		outdated++
+		fmt.Printf("%s: %d changes\n", s.Name, len(changes))
+		for i, c := range changes {
+			if i == maxChanges {
+				fmt.Printf("  ... %d more\n", len(changes)-maxChanges)
+				break
+			}
+			fmt.Printf("  %s\n", c)
+		}
+		if update {
+			if err := os.WriteFile(s.Spec, patched, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	switch {
+	case failed > 0:
+		return fmt.Errorf("%d specs couldn't be checked", failed)
+	case update && outdated > 0:
+		fmt.Printf("updated %d specs, run `make generate` to regenerate the clients\n", outdated)
+	case outdated > 0:
+		return fmt.Errorf("%d specs are outdated, run `make update-specs`", outdated)
+	}
+	return nil
+}
+
+// diff fetches and patches the upstream spec, and compares it with the committed spec
+func diff(ctx context.Context, s specs.Service, location string) ([]specs.Change, []byte, error) {
+	upstream, err := specs.Fetch(ctx, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	patched, err := s.Patch(upstream)
+	if err != nil {
+		return nil, nil, err
+	}
+	committed, err := s.Committed()
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := specs.Encode(patched)
+	if err != nil {
+		return nil, nil, err
+	}
+	return specs.Diff(committed, patched), b, nil
+}
@@ -0,0 +1,497 @@
+// validators generates Validate methods for the request bodies of every service package
+// the methods enforce the constraints of the spec, i.e. required fields, lengths, patterns,
+// enums and ranges, and are written to validators.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/internal/contract"
+)
+
+const (
+	root   = "."
+	output = "validators.go"
+	suffix = "JSONRequestBody"
+)
+
+type node = map[string]interface{}
+
+func main() {
+	validators, err := generateAll(root)
+	if err == nil {
+		for p, src := range validators {
+			if err = os.WriteFile(p, src, 0o644); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate validators: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("generated validators of %d packages\n", len(validators))
+}
+
+// generateAll generates the validators of all packages generated from the specs under root
+// it returns the source of each validators.go by its path
+func generateAll(root string) (map[string][]byte, error) {
+	targets, err := contract.Targets(root)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][]byte{}
+	for _, t := range targets {
+		spec, err := contract.Load(t.Spec)
+		if err != nil {
+			return nil, err
+		}
+		reqs, err := contract.ParseRequests(t.Package)
+		if err != nil {
+			return nil, err
+		}
+		dirs := map[string][]contract.Request{}
+		for _, r := range reqs {
+			dir := filepath.Dir(r.File)
+			dirs[dir] = append(dirs[dir], r)
+		}
+		for dir, reqs := range dirs {
+			src, err := generate(dir, spec, reqs)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", dir, err)
+			}
+			if src != nil {
+				res[filepath.Join(dir, output)] = src
+			}
+		}
+	}
+	return res, nil
+}
+
+// generate returns the validators of the package in dir
+// it returns nil if the package has no request bodies
+func generate(dir string, spec *contract.Spec, reqs []contract.Request) ([]byte, error) {
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{spec: spec, pkg: pkg, done: map[string]bool{}}
+
+	aliases := []string{}
+	for name := range pkg.types {
+		if strings.HasSuffix(name, suffix) {
+			aliases = append(aliases, name)
+		}
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		op := strings.TrimSuffix(alias, suffix)
+		var schema node
+		for _, r := range reqs {
+			if r.Func != "New"+op+"RequestWithBody" {
+				continue
+			}
+			if o := spec.Find(r.Method, r.Path); o != nil {
+				schema = o.Body
+			}
+		}
+		if schema == nil {
+			return nil, fmt.Errorf("no schema found for %s", alias)
+		}
+		if name, ok := pkg.structName(pkg.types[alias]); ok {
+			g.enqueue(name, schema)
+			continue
+		}
+		// bodies that aren't named structs, i.e. lists, can't have methods
+		g.function(alias, schema)
+	}
+	if len(g.queue) == 0 && len(g.methods) == 0 {
+		return nil, nil
+	}
+	for len(g.queue) > 0 {
+		j := g.queue[0]
+		g.queue = g.queue[1:]
+		g.method(j.name, j.schema)
+	}
+	sort.Slice(g.methods, func(i, j int) bool {
+		return g.methods[i].name < g.methods[j].name
+	})
+
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by internal/tools/validators. DO NOT EDIT.\n\npackage %s\n\n", pkg.name)
+	b.WriteString("import \"github.com/SchwarzIT/community-stackit-go-client/pkg/validate\"\n")
+	for _, m := range g.methods {
+		b.WriteString(m.src)
+	}
+	return format.Source(b.Bytes())
+}
+
+// goPackage are the type declarations of a package
+type goPackage struct {
+	name  string
+	types map[string]ast.Expr // type expression by type name
+	enums map[string]bool     // types with an IsValid method
+}
+
+// parsePackage parses the type declarations of the package in dir
+func parsePackage(dir string) (*goPackage, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	pkg := &goPackage{types: map[string]ast.Expr{}, enums: map[string]bool{}}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		base := filepath.Base(file)
+		if base == output || base == "fake.go" || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		pkg.name = f.Name.Name
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					pkg.types[ts.Name.Name] = ts.Type
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || d.Name.Name != "IsValid" {
+					continue
+				}
+				if id, ok := d.Recv.List[0].Type.(*ast.Ident); ok {
+					pkg.enums[id.Name] = true
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// underlying follows local type names to the type expression they're declared with
+// it stops at enums and structs, which have methods of their own
+func (p *goPackage) underlying(t ast.Expr) ast.Expr {
+	for i := 0; i < 32; i++ {
+		id, ok := t.(*ast.Ident)
+		if !ok || p.enums[id.Name] {
+			return t
+		}
+		next, ok := p.types[id.Name]
+		if !ok {
+			return t
+		}
+		if _, ok := next.(*ast.StructType); ok {
+			return t
+		}
+		t = next
+	}
+	return t
+}
+
+// structName returns the name of the local struct t refers to
+func (p *goPackage) structName(t ast.Expr) (string, bool) {
+	id, ok := p.underlying(t).(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	_, ok = p.types[id.Name].(*ast.StructType)
+	return id.Name, ok
+}
+
+type job struct {
+	name   string
+	schema node
+}
+
+type method struct {
+	name string
+	src  string
+}
+
+type generator struct {
+	spec    *contract.Spec
+	pkg     *goPackage
+	done    map[string]bool
+	queue   []job
+	methods []method
+}
+
+// enqueue schedules the Validate method of the struct name
+// the first schema a struct is reached with is used
+func (g *generator) enqueue(name string, schema node) {
+	if g.done[name] {
+		return
+	}
+	g.done[name] = true
+	g.queue = append(g.queue, job{name: name, schema: schema})
+}
+
+// method renders the Validate method of the struct name
+func (g *generator) method(name string, schema node) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "\n// Validate checks b against the constraints of the spec\n")
+	fmt.Fprintf(b, "func (b %s) Validate() error {\n\tv := &validate.Validator{}\n", name)
+	g.fields(b, "b", "", g.pkg.types[name].(*ast.StructType), schema, 0)
+	b.WriteString("\treturn v.Err()\n}\n")
+	g.methods = append(g.methods, method{name: name, src: b.String()})
+}
+
+// function renders a Validate<alias> function for a body that can't have methods
+func (g *generator) function(alias string, schema node) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "\n// Validate%s checks b against the constraints of the spec\n", alias)
+	fmt.Fprintf(b, "func Validate%s(b %s) error {\n\tv := &validate.Validator{}\n", alias, alias)
+	g.checks(b, "b", `""`, g.pkg.types[alias], schema, 0)
+	b.WriteString("\treturn v.Err()\n}\n")
+	g.methods = append(g.methods, method{name: "Validate" + alias, src: b.String()})
+}
+
+// fields writes the checks of the fields of st, which is accessed by base
+func (g *generator) fields(b *bytes.Buffer, base, prefix string, st *ast.StructType, schema node, depth int) {
+	props, required := g.properties(schema)
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 || f.Tag == nil {
+			continue
+		}
+		tag, _ := strconv.Unquote(f.Tag.Value)
+		key := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		s, _ := g.spec.Resolve(props[key]).(node)
+		if s["readOnly"] == true {
+			// read only fields are ignored in requests
+			continue
+		}
+		expr := base + "." + f.Names[0].Name
+		field := join(prefix, key)
+
+		if star, ok := f.Type.(*ast.StarExpr); ok {
+			if required[key] {
+				fmt.Fprintf(b, "v.Required(%s, %s != nil)\n", field, expr)
+			}
+			inner := &bytes.Buffer{}
+			g.checks(inner, "*"+expr, field, star.X, s, depth)
+			if inner.Len() > 0 {
+				fmt.Fprintf(b, "if %s != nil {\n%s}\n", expr, inner)
+			}
+			continue
+		}
+		switch g.pkg.underlying(f.Type).(type) {
+		case *ast.ArrayType, *ast.MapType:
+			// nil lists and maps are marshalled as null
+			if required[key] {
+				fmt.Fprintf(b, "v.Required(%s, %s != nil)\n", field, expr)
+			}
+		}
+		g.checks(b, expr, field, f.Type, s, depth)
+	}
+}
+
+// checks writes the checks of the value expr of type t against schema
+// field is the Go expression of the path of the value
+func (g *generator) checks(b *bytes.Buffer, expr, field string, t ast.Expr, schema node, depth int) {
+	if id, ok := t.(*ast.Ident); ok {
+		if g.pkg.enums[id.Name] {
+			fmt.Fprintf(b, "v.Add(%s, validate.CheckEnum(%s))\n", field, expr)
+			g.stringChecks(b, "string("+expr+")", field, schema, false)
+			return
+		}
+		if name, ok := g.pkg.structName(id); ok {
+			fmt.Fprintf(b, "v.Add(%s, %s.Validate())\n", field, selector(expr))
+			g.enqueue(name, schema)
+			return
+		}
+		// named basic types are converted to the type they're declared with
+		if basic, ok := g.pkg.underlying(id).(*ast.Ident); ok && basic.Name != id.Name {
+			expr = basic.Name + "(" + expr + ")"
+		}
+	}
+	if schema == nil {
+		schema = node{}
+	}
+
+	switch t := g.pkg.underlying(t).(type) {
+	case *ast.Ident:
+		switch {
+		case t.Name == "string":
+			g.stringChecks(b, expr, field, schema, true)
+		case strings.HasPrefix(t.Name, "int") || strings.HasPrefix(t.Name, "uint") || strings.HasPrefix(t.Name, "float"):
+			g.numberChecks(b, expr, field, schema)
+		}
+	case *ast.StructType:
+		g.fields(b, selector(expr), field, t, schema, depth)
+	case *ast.StarExpr:
+		inner := &bytes.Buffer{}
+		g.checks(inner, "*"+expr, field, t.X, schema, depth)
+		if inner.Len() > 0 {
+			fmt.Fprintf(b, "if %s != nil {\n%s}\n", expr, inner)
+		}
+	case *ast.ArrayType:
+		if n, ok := integer(schema["minItems"]); ok {
+			fmt.Fprintf(b, "v.MinItems(%s, len(%s), %d)\n", field, expr, n)
+		}
+		if n, ok := integer(schema["maxItems"]); ok {
+			fmt.Fprintf(b, "v.MaxItems(%s, len(%s), %d)\n", field, expr, n)
+		}
+		items, _ := g.spec.Resolve(schema["items"]).(node)
+		i, item := loopVars("i", "item", depth)
+		inner := &bytes.Buffer{}
+		g.checks(inner, item, "validate.Index("+field+", "+i+")", t.Elt, items, depth+1)
+		if inner.Len() > 0 {
+			fmt.Fprintf(b, "for %s, %s := range %s {\n%s}\n", i, item, expr, inner)
+		}
+	case *ast.MapType:
+		values, _ := g.spec.Resolve(schema["additionalProperties"]).(node)
+		k, value := loopVars("k", "value", depth)
+		inner := &bytes.Buffer{}
+		g.checks(inner, value, "validate.Key("+field+", "+k+")", t.Value, values, depth+1)
+		if inner.Len() > 0 {
+			fmt.Fprintf(b, "for %s, %s := range %s {\n%s}\n", k, value, expr, inner)
+		}
+	}
+}
+
+// stringChecks writes the length, pattern and enum checks of a string
+// enums are only checked by value if the Go type isn't an enum itself
+func (g *generator) stringChecks(b *bytes.Buffer, expr, field string, schema node, enum bool) {
+	if schema == nil {
+		return
+	}
+	if n, ok := integer(schema["minLength"]); ok {
+		fmt.Fprintf(b, "v.MinLength(%s, %s, %d)\n", field, expr, n)
+	}
+	if n, ok := integer(schema["maxLength"]); ok {
+		fmt.Fprintf(b, "v.MaxLength(%s, %s, %d)\n", field, expr, n)
+	}
+	if p, ok := schema["pattern"].(string); ok {
+		if _, err := regexp.Compile(p); err != nil {
+			fmt.Fprintf(b, "// the pattern %s isn't supported by the regexp package\n", strconv.Quote(p))
+		} else {
+			fmt.Fprintf(b, "v.Pattern(%s, %s, %s)\n", field, expr, quote(p))
+		}
+	}
+	values, _ := schema["enum"].([]interface{})
+	if !enum || len(values) == 0 {
+		return
+	}
+	list := []string{}
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			list = append(list, strconv.Quote(s))
+		}
+	}
+	if len(list) == len(values) {
+		fmt.Fprintf(b, "v.OneOf(%s, %s, %s)\n", field, expr, strings.Join(list, ", "))
+	}
+}
+
+// numberChecks writes the range checks of a number
+// both the boolean exclusive bounds of OpenAPI 3.0 and the numeric ones of 3.1 are supported
+func (g *generator) numberChecks(b *bytes.Buffer, expr, field string, schema node) {
+	bound := func(fn, limit, exclusive string) {
+		min, ok := number(schema[limit])
+		excl := schema[exclusive] == true
+		if n, isNumber := number(schema[exclusive]); isNumber {
+			min, ok, excl = n, true, true
+		}
+		if ok {
+			fmt.Fprintf(b, "v.%s(%s, float64(%s), %s, %t)\n", fn, field, expr, strconv.FormatFloat(min, 'f', -1, 64), excl)
+		}
+	}
+	bound("Minimum", "minimum", "exclusiveMinimum")
+	bound("Maximum", "maximum", "exclusiveMaximum")
+}
+
+// properties returns the properties and required properties of an object schema
+// including the ones of its allOf schemas
+func (g *generator) properties(schema node) (node, map[string]bool) {
+	props, required := node{}, map[string]bool{}
+	var walk func(s node, depth int)
+	walk = func(s node, depth int) {
+		if s == nil || depth > 16 {
+			return
+		}
+		if p, ok := s["properties"].(node); ok {
+			for k, v := range p {
+				props[k] = v
+			}
+		}
+		if r, ok := s["required"].([]interface{}); ok {
+			for _, k := range r {
+				if k, ok := k.(string); ok {
+					required[k] = true
+				}
+			}
+		}
+		all, _ := s["allOf"].([]interface{})
+		for _, a := range all {
+			n, _ := g.spec.Resolve(a).(node)
+			walk(n, depth+1)
+		}
+	}
+	walk(schema, 0)
+	return props, required
+}
+
+// join returns the Go expression of the path of key in the field prefix
+func join(prefix, key string) string {
+	if prefix == "" {
+		return strconv.Quote(key)
+	}
+	if p, err := strconv.Unquote(prefix); err == nil {
+		return strconv.Quote(p + "." + key)
+	}
+	return prefix + " + " + strconv.Quote("."+key)
+}
+
+// selector returns expr for selecting fields and methods
+// pointers don't need to be dereferenced for that
+func selector(expr string) string {
+	return strings.TrimPrefix(expr, "*")
+}
+
+// loopVars returns the names of the loop variables of a loop nested depth times
+func loopVars(index, value string, depth int) (string, string) {
+	if depth == 0 {
+		return index, value
+	}
+	return index + strconv.Itoa(depth), value + strconv.Itoa(depth)
+}
+
+// quote returns s as raw string literal if possible
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+func number(v interface{}) (float64, bool) {
+	f, ok := v.(float64)
+	return f, ok
+}
+
+func integer(v interface{}) (int, bool) {
+	f, ok := number(v)
+	return int(f), ok
+}
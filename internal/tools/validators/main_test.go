@@ -0,0 +1,70 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	alertgroups "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/alert-groups"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsUpToDate(t *testing.T) {
+	validators, err := generateAll(filepath.Join("..", "..", ".."))
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	for p, src := range validators {
+		b, err := os.ReadFile(p)
+		if assert.NoError(t, err, "run `make validators`") {
+			assert.Equal(t, string(src), string(b), "%s is outdated, run `make validators`", p)
+		}
+	}
+}
+
+func TestValidators_Cluster(t *testing.T) {
+	body := cluster.SkeServiceCreateOrUpdateClusterRequest{
+		Kubernetes: cluster.Kubernetes{Version: "1.25.5"},
+		Nodepools: []cluster.Nodepool{{
+			AvailabilityZones: []string{"eu01-1"},
+			Machine:           cluster.Machine{Type: "c1.2", Image: cluster.Image{Version: "3510.2.2"}},
+			Maximum:           3,
+			Minimum:           1,
+			Name:              "pool",
+			Volume:            cluster.Volume{Size: 20},
+		}},
+	}
+	assert.NoError(t, body.Validate())
+
+	body.Kubernetes.Version = "1.25"
+	body.Nodepools[0].Maximum = 0
+	body.Nodepools[0].Taints = &[]cluster.Taint{{Effect: "NoRun", Key: "key"}}
+	err := body.Validate()
+	assert.EqualError(t, err, `kubernetes.version: must match ^\d+\.\d+\.\d+$; `+
+		`nodepools[0].maximum: must be at least 1; `+
+		`nodepools[0].taints[0].effect: "NoRun" isn't a valid cluster.TaintEffect, valid values are: NoExecute, NoSchedule, PreferNoSchedule`)
+
+	var fe validate.FieldErrors
+	require.ErrorAs(t, err, &fe)
+	assert.Equal(t, "nodepools[0].maximum", fe[1].Field)
+
+	assert.EqualError(t, cluster.SkeServiceCreateOrUpdateClusterRequest{}.Validate(), `kubernetes.version: must match ^\d+\.\d+\.\d+$; `+
+		`nodepools: is required; nodepools: must have at least 1 items`)
+}
+
+func TestValidators_LoadBalancer(t *testing.T) {
+	name := "my_lb"
+	assert.EqualError(t, instances.LoadBalancer{Name: &name}.Validate(), "name: must match ^[0-9a-z](?:(?:[0-9a-z]|-){0,61}[0-9a-z])?$")
+	name = "my-lb"
+	assert.NoError(t, instances.LoadBalancer{Name: &name}.Validate())
+}
+
+func TestValidators_List(t *testing.T) {
+	body := alertgroups.PartialUpdateJSONRequestBody{{Name: "group"}, {Name: ""}}
+	assert.EqualError(t, alertgroups.ValidatePartialUpdateJSONRequestBody(body),
+		"[0].rules: is required; [1].name: must have at least 1 characters; [1].rules: is required")
+}
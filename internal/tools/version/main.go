@@ -0,0 +1,40 @@
+// version generates pkg/clients/version.go with the SDK version
+// the version is read from the VERSION environment variable, or from the latest git tag
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const output = "pkg/clients/version.go"
+
+const template = `// Code generated by internal/tools/version. DO NOT EDIT.
+
+package clients
+
+// Version is the version of the SDK
+// it is updated at release time by running ` + "`make version`" + `
+const Version = %q
+`
+
+func main() {
+	version := os.Getenv("VERSION")
+	if version == "" {
+		out, err := exec.Command("git", "describe", "--tags", "--abbrev=0").Output()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to read version from git: %s\n", err)
+			os.Exit(1)
+		}
+		version = strings.TrimSpace(string(out))
+	}
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if err := os.WriteFile(output, []byte(fmt.Sprintf(template, version)), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %s\n", output, err)
+		os.Exit(1)
+	}
+}
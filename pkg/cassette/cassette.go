@@ -0,0 +1,258 @@
+// Package cassette records HTTP interactions of the SDK to a file and replays them offline
+// the Recorder is an http.RoundTripper, set it as Transport of the KeyFlow or TokenFlow config
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Mode is the mode of a Recorder
+type Mode int
+
+const (
+	// Replay answers requests with the recorded interactions
+	Replay Mode = iota
+	// Record sends requests and records the interactions
+	Record
+)
+
+// ErrUnmatched is returned in strict replay mode for requests without a recorded interaction
+var ErrUnmatched = errors.New("no recorded interaction matches the request")
+
+// Config is the recorder config
+type Config struct {
+	// Mode is the recorder mode, Replay by default
+	Mode Mode
+
+	// Strict fails requests without a recorded interaction when replaying
+	// instead of sending them with Transport
+	Strict bool
+
+	// Transport sends requests when recording and unmatched requests when replaying
+	// http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+
+	// Redact are additional header names and JSON or form fields to redact
+	Redact []string
+}
+
+// Cassette is the content of a cassette file
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request
+// the URL query and body are normalized and sanitized
+type Request struct {
+	Method  string              `json:"method" yaml:"method"`
+	URL     string              `json:"url" yaml:"url"`
+	Headers map[string][]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string              `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	StatusCode int                 `json:"status_code" yaml:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string              `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Recorder records or replays HTTP interactions
+type Recorder struct {
+	mu        sync.Mutex
+	path      string
+	config    Config
+	transport http.RoundTripper
+	sanitizer sanitizer
+	cassette  Cassette
+	used      []bool
+}
+
+// New returns a recorder for the cassette file at path
+// files ending with .yaml or .yml are written as YAML, all others as JSON
+// in Replay mode the file is loaded and has to exist
+func New(path string, cfg ...Config) (*Recorder, error) {
+	c := Config{}
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	r := &Recorder{
+		path:      path,
+		config:    c,
+		transport: c.Transport,
+		sanitizer: newSanitizer(c.Redact),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if c.Mode == Replay {
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Interactions returns the recorded interactions
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction{}, r.cassette.Interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file
+// it does nothing in Replay mode
+func (r *Recorder) Save() error {
+	if r.config.Mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b []byte
+	var err error
+	if isYAML(r.path) {
+		b, err = yaml.Marshal(r.cassette)
+	} else {
+		b, err = json.MarshalIndent(r.cassette, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, b, 0o600)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	recorded := r.sanitizer.request(req, body)
+
+	if r.config.Mode == Record {
+		return r.record(req, recorded)
+	}
+	if res, ok := r.replay(req, recorded); ok {
+		return res, nil
+	}
+	if r.config.Strict {
+		return nil, errors.Wrapf(ErrUnmatched, "%s %s", recorded.Method, recorded.URL)
+	}
+	return r.transport.RoundTrip(req)
+}
+
+// record sends the request and records the interaction
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  recorded,
+		Response: r.sanitizer.response(res, b),
+	})
+	return res, nil
+}
+
+// replay returns the response of the first unused interaction matching the request
+// if all matching interactions were used, the last one is replayed again
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for i, in := range r.cassette.Interactions {
+		if !matches(in.Request, recorded) {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, false
+	}
+	r.used[match] = true
+
+	in := r.cassette.Interactions[match].Response
+	header := http.Header{}
+	for k, v := range in.Headers {
+		header[k] = append([]string{}, v...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(in.Body)),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}, true
+}
+
+func (r *Recorder) load() error {
+	b, err := os.ReadFile(r.path)
+	if err != nil {
+		return errors.Wrap(err, "failed to load cassette")
+	}
+	if isYAML(r.path) {
+		err = yaml.Unmarshal(b, &r.cassette)
+	} else {
+		err = json.Unmarshal(b, &r.cassette)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse cassette %s", r.path)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return nil
+}
+
+// matches reports whether a recorded request matches by method, URL and normalized body
+func matches(recorded, req Request) bool {
+	return recorded.Method == req.Method && recorded.URL == req.URL && recorded.Body == req.Body
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
@@ -0,0 +1,107 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/stackittest"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// offline fails all requests, to make sure nothing is sent when replaying
+type offline struct{}
+
+func (offline) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("offline")
+}
+
+// createProject creates a project and waits for it to become active
+func createProject(t *testing.T, cfg clients.KeyFlowConfig) string {
+	ctx := context.Background()
+	kf := &clients.KeyFlow{}
+	require.NoError(t, kf.Init(ctx, cfg))
+	c, err := services.Init(kf)
+	require.NoError(t, err)
+
+	res, err := c.ResourceManagement.Create(ctx, resourcemanagement.ProjectRequestBody{
+		Name:              "my-project",
+		ContainerParentID: "organization-123",
+		Members:           []resourcemanagement.ProjectMember{},
+	})
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+
+	h := res.WaitHandler(ctx, c.ResourceManagement, res.JSON201.ContainerID).SetInitialDelay(0)
+	require.NoError(t, h.SetThrottle(time.Millisecond))
+	project, err := h.Wait(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, resourcemanagement.ACTIVE, project.JSON200.LifecycleState)
+	return project.JSON200.ContainerID
+}
+
+func TestRecorder(t *testing.T) {
+	for _, file := range []string{"cassette.yaml", "cassette.json"} {
+		t.Run(file, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "testdata", file)
+
+			srv := stackittest.NewServer()
+			defer srv.Close()
+			cfg := srv.KeyFlowConfig()
+			rec, err := New(path, Config{Mode: Record, Transport: cfg.Transport})
+			require.NoError(t, err)
+			cfg.Transport = rec
+			recorded := createProject(t, cfg)
+			require.NoError(t, rec.Save())
+			require.NotEmpty(t, rec.Interactions())
+
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.NotContains(t, string(b), "Authorization", "authorization headers should be stripped")
+			assert.NotContains(t, string(b), "eyJ", "tokens should be redacted")
+			assert.Contains(t, string(b), "assertion="+Redacted)
+
+			// replay with another key and without network
+			other := stackittest.NewServer()
+			defer other.Close()
+			cfg = other.KeyFlowConfig()
+			rec, err = New(path, Config{Strict: true, Transport: offline{}})
+			require.NoError(t, err)
+			cfg.Transport = rec
+			assert.Equal(t, recorded, createProject(t, cfg))
+
+			req, err := http.NewRequest(http.MethodGet, "https://resource-manager.api.stackit.cloud/v2/unknown", nil)
+			require.NoError(t, err)
+			_, err = rec.RoundTrip(req)
+			assert.ErrorIs(t, err, ErrUnmatched)
+		})
+	}
+}
+
+func TestRecorder_NotStrict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions": []}`), 0o600))
+
+	rec, err := New(path, Config{Transport: offline{}})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+	_, err = rec.RoundTrip(req)
+	assert.EqualError(t, err, "offline", "unmatched requests should be sent with the transport")
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+
+	_, err = New(filepath.Join(t.TempDir(), "missing.yaml"), Config{Mode: Record})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,158 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces redacted values
+const Redacted = "REDACTED"
+
+// redacted are the header names and fields redacted by default
+var redacted = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"token",
+	"assertion",
+	"password",
+	"secret",
+	"client_secret",
+	"privateKey",
+	"kubeconfig",
+}
+
+// userinfo matches passwords in URLs, i.e. in DSA credentials
+var userinfo = regexp.MustCompile(`(://[^:/@\s"]+:)[^@/\s"]+@`)
+
+// sanitizer removes secrets from recorded requests and responses
+type sanitizer struct {
+	names map[string]bool
+}
+
+func newSanitizer(extra []string) sanitizer {
+	s := sanitizer{names: map[string]bool{}}
+	for _, name := range append(append([]string{}, redacted...), extra...) {
+		s.names[normalizeName(name)] = true
+	}
+	return s
+}
+
+// request returns the sanitized request
+func (s sanitizer) request(req *http.Request, body []byte) Request {
+	return Request{
+		Method:  req.Method,
+		URL:     s.url(req.URL),
+		Headers: s.headers(req.Header),
+		Body:    s.body(req.Header.Get("Content-Type"), body),
+	}
+}
+
+// response returns the sanitized response
+func (s sanitizer) response(res *http.Response, body []byte) Response {
+	headers := s.headers(res.Header)
+	// the sanitized body may have a different length
+	delete(headers, "Content-Length")
+	return Response{
+		StatusCode: res.StatusCode,
+		Headers:    headers,
+		Body:       s.body(res.Header.Get("Content-Type"), body),
+	}
+}
+
+func (s sanitizer) headers(h http.Header) map[string][]string {
+	res := map[string][]string{}
+	for k, v := range h {
+		if !s.redacted(k) {
+			res[k] = append([]string{}, v...)
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+// url returns the URL with a sorted and sanitized query
+func (s sanitizer) url(u *url.URL) string {
+	c := *u
+	if _, ok := c.User.Password(); ok {
+		c.User = url.UserPassword(c.User.Username(), Redacted)
+	}
+	c.RawQuery = s.values(c.Query()).Encode()
+	return c.String()
+}
+
+// body returns the normalized and sanitized body
+// JSON is re-encoded with sorted keys, forms are sorted by key
+func (s sanitizer) body(contentType string, b []byte) string {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return ""
+	}
+	var v interface{}
+	if json.Unmarshal(b, &v) == nil {
+		if out, err := json.Marshal(s.json(v)); err == nil {
+			return string(out)
+		}
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(b)); err == nil {
+			return s.values(values).Encode()
+		}
+	}
+	return s.string(string(b))
+}
+
+func (s sanitizer) json(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if s.redacted(k) {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = s.json(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = s.json(item)
+		}
+	case string:
+		return s.string(v)
+	}
+	return v
+}
+
+func (s sanitizer) values(values url.Values) url.Values {
+	for k, v := range values {
+		for i := range v {
+			if s.redacted(k) {
+				v[i] = Redacted
+				continue
+			}
+			v[i] = s.string(v[i])
+		}
+	}
+	return values
+}
+
+func (s sanitizer) string(v string) string {
+	return userinfo.ReplaceAllString(v, "${1}"+Redacted+"@")
+}
+
+func (s sanitizer) redacted(name string) bool {
+	return s.names[normalizeName(name)]
+}
+
+// normalizeName makes names comparable across conventions, i.e. privateKey and private_key
+func normalizeName(name string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+}
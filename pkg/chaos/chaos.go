@@ -0,0 +1,257 @@
+// Package chaos injects faults into HTTP requests, to test retries and wait handlers against flaky APIs
+// the Transport is an http.RoundTripper, set it as Transport of the KeyFlow or TokenFlow config
+package chaos
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Fault is a fault injected into a request
+// the zero value sends the request unchanged
+type Fault struct {
+	// Latency delays the request
+	Latency time.Duration
+
+	// Err fails the request with the error, the request isn't sent
+	Err error
+
+	// StatusCode responds with the status code, the request isn't sent
+	StatusCode int
+
+	// Header is added to the response with StatusCode
+	Header http.Header
+
+	// Truncate sends the request and cuts the response body in half
+	// reading the body fails with io.ErrUnexpectedEOF
+	Truncate bool
+}
+
+// None sends the request unchanged
+func None() Fault {
+	return Fault{}
+}
+
+// Latency delays the request by d
+func Latency(d time.Duration) Fault {
+	return Fault{Latency: d}
+}
+
+// ConnectionReset fails the request as if the server reset the connection
+func ConnectionReset() Fault {
+	return Fault{Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}
+}
+
+// ConnectionRefused fails the request as if the server refused the connection
+func ConnectionRefused() Fault {
+	return Fault{Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}
+}
+
+// EOF fails the request as if the server closed the connection before responding
+func EOF() Fault {
+	return Fault{Err: io.ErrUnexpectedEOF}
+}
+
+// Status responds with the status code, i.e. http.StatusTooManyRequests or http.StatusBadGateway
+func Status(code int) Fault {
+	return Fault{StatusCode: code}
+}
+
+// Truncated sends the request and truncates the response body
+func Truncated() Fault {
+	return Fault{Truncate: true}
+}
+
+// Config is the chaos transport config
+type Config struct {
+	// Transport sends the requests, http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+
+	// Match selects the requests faults are injected into, all requests if nil
+	Match func(*http.Request) bool
+
+	// Seed seeds the random faults, runs with the same seed inject the same faults
+	Seed int64
+}
+
+// rule injects a fault with a probability
+type rule struct {
+	probability float64
+	fault       Fault
+}
+
+// Transport injects faults into requests
+// scripted faults are injected first, one per request, then faults are injected by probability
+type Transport struct {
+	mu        sync.Mutex
+	transport http.RoundTripper
+	match     func(*http.Request) bool
+	rand      *rand.Rand
+	script    []Fault
+	rules     []rule
+	requests  int
+	injected  int
+}
+
+// New returns a new chaos transport
+func New(cfg ...Config) *Transport {
+	c := Config{}
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	t := &Transport{
+		transport: c.Transport,
+		match:     c.Match,
+		rand:      rand.New(rand.NewSource(c.Seed)),
+	}
+	if t.transport == nil {
+		t.transport = http.DefaultTransport
+	}
+	return t
+}
+
+// Script appends faults injected in order, one per matching request
+func (t *Transport) Script(faults ...Fault) *Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.script = append(t.script, faults...)
+	return t
+}
+
+// Inject injects the fault into matching requests with the given probability between 0 and 1
+// rules are evaluated in the order they were added, the first hit wins
+func (t *Transport) Inject(probability float64, fault Fault) *Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rules = append(t.rules, rule{probability: probability, fault: fault})
+	return t
+}
+
+// Requests returns the number of matching requests
+func (t *Transport) Requests() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.requests
+}
+
+// Injected returns the number of requests a fault was injected into
+func (t *Transport) Injected() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.match != nil && !t.match(req) {
+		return t.transport.RoundTrip(req)
+	}
+	f := t.next()
+
+	if f.Latency > 0 {
+		timer := time.NewTimer(f.Latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			closeBody(req)
+			return nil, req.Context().Err()
+		}
+	}
+	if f.Err != nil {
+		closeBody(req)
+		return nil, f.Err
+	}
+	if f.StatusCode != 0 {
+		closeBody(req)
+		return response(req, f), nil
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil || !f.Truncate {
+		return res, err
+	}
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = &truncated{r: strings.NewReader(string(b[:len(b)/2]))}
+	res.ContentLength = -1
+	res.Header.Del("Content-Length")
+	return res, nil
+}
+
+// next returns the fault for the next matching request
+func (t *Transport) next() Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requests++
+
+	f := Fault{}
+	if len(t.script) > 0 {
+		f, t.script = t.script[0], t.script[1:]
+	} else {
+		for _, r := range t.rules {
+			if t.rand.Float64() < r.probability {
+				f = r.fault
+				break
+			}
+		}
+	}
+	if f.Latency > 0 || f.Err != nil || f.StatusCode != 0 || f.Truncate {
+		t.injected++
+	}
+	return f
+}
+
+// response returns the response for a fault with a status code
+func response(req *http.Request, f Fault) *http.Response {
+	body := fmt.Sprintf(`{"message":"chaos: injected %d %s"}`, f.StatusCode, http.StatusText(f.StatusCode))
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	for k, v := range f.Header {
+		header[k] = append([]string{}, v...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// truncated is a body failing with io.ErrUnexpectedEOF after its content
+type truncated struct {
+	r io.Reader
+}
+
+func (t *truncated) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (t *truncated) Close() error {
+	return nil
+}
@@ -0,0 +1,217 @@
+package chaos
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/stackittest"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetry retries quickly, to keep the tests short
+func fastRetry(maxRetries int) *clients.RetryConfig {
+	return &clients.RetryConfig{
+		MaxRetries:       maxRetries,
+		WaitBetweenCalls: time.Millisecond,
+		RetryTimeout:     5 * time.Second,
+		ClientTimeout:    time.Second,
+	}
+}
+
+// newServer starts a server answering "ok" and counting the requests it received
+func newServer(t *testing.T) (*httptest.Server, *int32) {
+	hits := new(int32)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, hits
+}
+
+// newFlow returns a token flow sending requests through ch
+func newFlow(t *testing.T, ch *Transport, retry *clients.RetryConfig) *clients.TokenFlow {
+	tf := &clients.TokenFlow{}
+	require.NoError(t, tf.Init(context.Background(), clients.TokenFlowConfig{
+		ServiceAccountEmail: "sa@example.com",
+		ServiceAccountToken: "token",
+		ClientRetry:         retry,
+		Transport:           ch,
+	}))
+	return tf
+}
+
+func TestTransport_ClientRetries(t *testing.T) {
+	srv, hits := newServer(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		faults   []Fault
+		wantErr  string
+		wantCode int
+		wantHits int32
+	}{
+		{"retried faults", http.MethodGet, []Fault{Status(http.StatusBadGateway), ConnectionRefused(), EOF()}, "", http.StatusOK, 1},
+		{"connection reset isn't retried", http.MethodGet, []Fault{ConnectionReset()}, "connection reset by peer", 0, 0},
+		{"too many requests isn't retried", http.MethodGet, []Fault{Status(http.StatusTooManyRequests)}, "", http.StatusTooManyRequests, 0},
+		{"EOF of non idempotent request isn't retried", http.MethodPost, []Fault{EOF()}, "unexpected EOF", 0, 0},
+		{"retries are exhausted", http.MethodGet, []Fault{Status(http.StatusBadGateway), Status(http.StatusInternalServerError), Status(http.StatusGatewayTimeout), Status(http.StatusBadGateway)}, "", http.StatusBadGateway, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt32(hits, 0)
+			ch := New().Script(tt.faults...)
+			req, err := http.NewRequest(tt.method, srv.URL, nil)
+			require.NoError(t, err)
+
+			res, err := newFlow(t, ch, fastRetry(3)).Do(req)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantCode, res.StatusCode)
+				res.Body.Close()
+			}
+			assert.Equal(t, tt.wantHits, atomic.LoadInt32(hits))
+		})
+	}
+}
+
+func TestTransport_Latency(t *testing.T) {
+	srv, hits := newServer(t)
+	retry := fastRetry(1)
+	retry.ClientTimeout = 50 * time.Millisecond
+
+	ch := New().Script(Latency(time.Second))
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	res, err := newFlow(t, ch, retry).Do(req)
+	require.NoError(t, err, "the client timeout should be retried")
+	res.Body.Close()
+	assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+	assert.Equal(t, 2, ch.Requests())
+	assert.Equal(t, 1, ch.Injected())
+}
+
+func TestTransport_Truncated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "my-project"}`))
+	}))
+	defer srv.Close()
+
+	res, err := (&http.Client{Transport: New().Script(Truncated())}).Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, `{"name": "m`, string(b))
+}
+
+func TestTransport_Probability(t *testing.T) {
+	srv, _ := newServer(t)
+	sequence := func(seed int64) []int {
+		ch := New(Config{Seed: seed}).Inject(0.5, Status(http.StatusServiceUnavailable))
+		c := &http.Client{Transport: ch}
+		res := []int{}
+		for i := 0; i < 100; i++ {
+			r, err := c.Get(srv.URL)
+			require.NoError(t, err)
+			r.Body.Close()
+			res = append(res, r.StatusCode)
+		}
+		assert.Equal(t, 100, ch.Requests())
+		assert.InDelta(t, 50, ch.Injected(), 20)
+		return res
+	}
+	assert.Equal(t, sequence(42), sequence(42), "the same seed should inject the same faults")
+}
+
+func TestTransport_Match(t *testing.T) {
+	srv, hits := newServer(t)
+	ch := New(Config{Match: func(r *http.Request) bool {
+		return r.Method == http.MethodPost
+	}}).Inject(1, ConnectionReset())
+	c := &http.Client{Transport: ch}
+
+	res, err := c.Get(srv.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	_, err = c.Post(srv.URL, "text/plain", strings.NewReader("body"))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+	assert.Equal(t, 1, ch.Requests())
+}
+
+func TestTransport_WaitHandler(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	// only polling of the project is disturbed, authentication and creation succeed
+	cfg := srv.KeyFlowConfig()
+	ch := New(Config{Transport: cfg.Transport, Match: func(r *http.Request) bool {
+		return r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/projects/")
+	}})
+	cfg.Transport = ch
+	cfg.ClientRetry = fastRetry(0)
+	kf := &clients.KeyFlow{}
+	require.NoError(t, kf.Init(ctx, cfg))
+	c, err := services.Init(kf)
+	require.NoError(t, err)
+
+	create := func() (*resourcemanagement.CreateResponse, string) {
+		res, err := c.ResourceManagement.Create(ctx, resourcemanagement.ProjectRequestBody{
+			Name:              "my-project",
+			ContainerParentID: "organization-123",
+			Members:           []resourcemanagement.ProjectMember{},
+		})
+		require.NoError(t, validate.Response(res, err, "JSON201"))
+		return res, res.JSON201.ContainerID
+	}
+	waitFor := func(h *wait.Handler[*resourcemanagement.GetResponse]) error {
+		require.NoError(t, h.SetThrottle(time.Millisecond))
+		_, err := h.SetInitialDelay(0).SetTimeout(5 * time.Second).Wait(ctx)
+		return err
+	}
+
+	t.Run("transient errors are tolerated", func(t *testing.T) {
+		ch.Script(Status(http.StatusServiceUnavailable), Status(http.StatusTooManyRequests), ConnectionReset(), Status(http.StatusBadGateway))
+		res, id := create()
+		require.NoError(t, waitFor(res.WaitHandler(ctx, c.ResourceManagement, id)))
+		assert.GreaterOrEqual(t, ch.Injected(), 4)
+	})
+
+	t.Run("transient budget is exhausted", func(t *testing.T) {
+		ch.Script(Status(http.StatusServiceUnavailable), Status(http.StatusServiceUnavailable), Status(http.StatusServiceUnavailable))
+		res, id := create()
+		h := res.WaitHandler(ctx, c.ResourceManagement, id)
+		h.SetTransientClassifier(h.GetTransientClassifier().WithBudget(2))
+		err := waitFor(h)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "giving up after 2 consecutive transient errors")
+	})
+
+	t.Run("non transient errors stop the wait", func(t *testing.T) {
+		ch.Script(Status(http.StatusBadRequest))
+		res, id := create()
+		err := waitFor(res.WaitHandler(ctx, c.ResourceManagement, id))
+		require.Error(t, err)
+		var re *wait.RequestError
+		require.ErrorAs(t, err, &re)
+		assert.Equal(t, http.StatusBadRequest, re.StatusCode)
+	})
+}
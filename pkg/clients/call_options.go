@@ -0,0 +1,90 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	// IdempotencyKeyHeader is the header used for passing an idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+)
+
+type callOptionsKey struct{}
+
+// callOptions are per-call options carried by the request context
+type callOptions struct {
+	retry          *RetryConfig
+	timeout        *time.Duration
+	idempotencyKey string
+}
+
+// WithCallRetry returns a context that overrides the flow's retry configuration
+// for calls made with it
+func WithCallRetry(ctx context.Context, policy RetryConfig) context.Context {
+	o := getCallOptions(ctx)
+	o.retry = &policy
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// WithCallTimeout returns a context that overrides the HTTP client timeout
+// for calls made with it
+func WithCallTimeout(ctx context.Context, d time.Duration) context.Context {
+	o := getCallOptions(ctx)
+	o.timeout = &d
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// WithIdempotencyKey returns a context that sets the Idempotency-Key header
+// on calls made with it. since the server can de-duplicate such calls,
+// they are retried on unexpected EOF regardless of their method
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	o := getCallOptions(ctx)
+	o.idempotencyKey = key
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// getCallOptions returns the call options carried by a given context
+func getCallOptions(ctx context.Context) callOptions {
+	if ctx == nil {
+		return callOptions{}
+	}
+	if o, ok := ctx.Value(callOptionsKey{}).(callOptions); ok {
+		return o
+	}
+	return callOptions{}
+}
+
+// applyCallOptions applies the call options carried by the request's context
+// and returns the client and retry configuration to use for the call
+func applyCallOptions(client *http.Client, req *http.Request, cfg *RetryConfig) (*http.Client, *RetryConfig) {
+	o := getCallOptions(req.Context())
+	timeout := client.Timeout
+	if o.retry != nil {
+		rc := *o.retry
+		if rc.Traceparent == nil {
+			rc.Traceparent = cfg.Traceparent
+		}
+		if rc.ClientTimeout != 0 {
+			timeout = rc.ClientTimeout
+		}
+		cfg = &rc
+	}
+	if o.timeout != nil {
+		timeout = *o.timeout
+	}
+	if timeout != client.Timeout {
+		// copy the client so the override doesn't leak to other calls
+		nc := *client
+		nc.Timeout = timeout
+		client = &nc
+	}
+	if o.idempotencyKey != "" {
+		if req.Header == nil {
+			req.Header = http.Header{}
+		}
+		req.Header.Set(IdempotencyKeyHeader, o.idempotencyKey)
+	}
+	return client, cfg
+}
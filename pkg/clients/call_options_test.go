@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_applyCallOptions(t *testing.T) {
+	tp := true
+	base := &RetryConfig{MaxRetries: 3, WaitBetweenCalls: time.Second, RetryTimeout: time.Minute, ClientTimeout: time.Minute, Traceparent: &tp}
+
+	t.Run("no options", func(t *testing.T) {
+		client := &http.Client{Timeout: time.Minute}
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		gotClient, gotCfg := applyCallOptions(client, req, base)
+		assert.Same(t, client, gotClient)
+		assert.Same(t, base, gotCfg)
+		assert.Empty(t, req.Header.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		client := &http.Client{Timeout: time.Minute}
+		ctx := WithCallRetry(context.Background(), RetryConfig{MaxRetries: 10, WaitBetweenCalls: time.Millisecond, RetryTimeout: time.Hour})
+		ctx = WithCallTimeout(ctx, 10*time.Minute)
+		ctx = WithIdempotencyKey(ctx, "key")
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+
+		gotClient, gotCfg := applyCallOptions(client, req, base)
+		assert.NotSame(t, client, gotClient)
+		assert.Equal(t, time.Minute, client.Timeout)
+		assert.Equal(t, 10*time.Minute, gotClient.Timeout)
+		assert.Equal(t, 10, gotCfg.MaxRetries)
+		assert.Equal(t, time.Hour, gotCfg.RetryTimeout)
+		assert.Equal(t, &tp, gotCfg.Traceparent)
+		assert.Equal(t, "key", req.Header.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("retry client timeout", func(t *testing.T) {
+		client := &http.Client{Timeout: time.Minute}
+		ctx := WithCallRetry(context.Background(), RetryConfig{ClientTimeout: 5 * time.Minute})
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+
+		gotClient, _ := applyCallOptions(client, req, base)
+		assert.Equal(t, 5*time.Minute, gotClient.Timeout)
+	})
+}
+
+func Test_do_WithCallRetry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprintln(w, `{}`)
+	}))
+	defer server.Close()
+
+	cfg := &RetryConfig{0, time.Millisecond, time.Second, DefaultClientTimeout, nil}
+	ctx := WithCallRetry(context.Background(), RetryConfig{2, time.Millisecond, time.Second, DefaultClientTimeout, nil})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	res, err := do(&http.Client{}, req, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
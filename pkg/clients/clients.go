@@ -55,6 +55,7 @@ func do(client *http.Client, req *http.Request, cfg *RetryConfig) (resp *http.Re
 		client = &http.Client{}
 	}
 	client.Timeout = cfg.ClientTimeout
+	client, cfg = applyCallOptions(client, req, cfg)
 	maxRetries := cfg.MaxRetries
 	err = wait.PollImmediate(cfg.WaitBetweenCalls, cfg.RetryTimeout, wait.ConditionFunc(
 		func() (bool, error) {
@@ -66,7 +67,8 @@ func do(client *http.Client, req *http.Request, cfg *RetryConfig) (resp *http.Re
 			if err != nil {
 				if maxRetries > 0 {
 					if validate.ErrorIsOneOf(err, ClientTimeoutErr, ClientContextDeadlineErr, ClientConnectionRefusedErr) ||
-						(req.Method == http.MethodGet && strings.Contains(err.Error(), ClientEOFError)) {
+						((req.Method == http.MethodGet || req.Header.Get(IdempotencyKeyHeader) != "") &&
+							strings.Contains(err.Error(), ClientEOFError)) {
 
 						// reduce retries counter and retry
 						maxRetries = maxRetries - 1
@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+)
+
+const (
+	DefaultJWKSCacheTTL = 15 * time.Minute
+)
+
+// JWKSCache caches the JWKS used for validating tokens
+// a single cache can be shared between multiple key flows
+type JWKSCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	jwks    *keyfunc.JWKS
+	fetched time.Time
+}
+
+// NewJWKSCache returns a new JWKS cache
+// if ttl is 0, DefaultJWKSCacheTTL is used
+func NewJWKSCache(ttl time.Duration) *JWKSCache {
+	if ttl == 0 {
+		ttl = DefaultJWKSCacheTTL
+	}
+	return &JWKSCache{ttl: ttl}
+}
+
+// get returns the cached JWKS or fetches it if the cache is empty or expired
+func (j *JWKSCache) get(fetch func() ([]byte, error)) (*keyfunc.JWKS, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.jwks != nil && time.Since(j.fetched) < j.ttl {
+		return j.jwks, nil
+	}
+	b, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	jwks, err := keyfunc.NewJSON(json.RawMessage(b))
+	if err != nil {
+		return nil, err
+	}
+	j.jwks = jwks
+	j.fetched = time.Now()
+	return jwks, nil
+}
+
+// Reset clears the cache
+func (j *JWKSCache) Reset() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.jwks = nil
+}
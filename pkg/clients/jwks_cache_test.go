@@ -0,0 +1,38 @@
+package clients
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKSCache_get(t *testing.T) {
+	calls := 0
+	fetch := func() ([]byte, error) {
+		calls++
+		return []byte(`{"keys": []}`), nil
+	}
+
+	c := NewJWKSCache(time.Hour)
+	for i := 0; i < 3; i++ {
+		_, err := c.get(fetch)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+
+	c.Reset()
+	_, err := c.get(fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	expired := NewJWKSCache(time.Nanosecond)
+	_, _ = expired.get(fetch)
+	time.Sleep(time.Millisecond)
+	_, _ = expired.get(fetch)
+	assert.Equal(t, 4, calls)
+
+	_, err = NewJWKSCache(0).get(func() ([]byte, error) { return nil, errors.New("fetch failed") })
+	assert.Error(t, err)
+}
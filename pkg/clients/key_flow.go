@@ -65,6 +65,9 @@ type KeyFlowConfig struct {
 	PrivateKey            []byte
 	ClientRetry           *RetryConfig
 	EnableTraceparent     bool
+	UserAgent             string            // Product token appended to the User-Agent, i.e. my-tool/1.0.0
+	Transport             http.RoundTripper // HTTP transport, http.DefaultTransport is used if nil
+	JWKSCache             *JWKSCache        // JWKS cache, the JWKS is fetched for every token validation if nil
 }
 
 // TokenResponseBody is the API response
@@ -151,6 +154,7 @@ func (c *KeyFlow) Do(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	setUserAgent(req, c.config.UserAgent)
 	return c.doer(c.client, req, c.config.ClientRetry)
 }
 
@@ -211,6 +215,20 @@ func (c *KeyFlow) mergeConfigs(cfg, currentCfg *KeyFlowConfig) *KeyFlowConfig {
 	if len(cfg.PrivateKey) != 0 {
 		merged.PrivateKey = cfg.PrivateKey
 	}
+	if cfg.UserAgent != "" {
+		merged.UserAgent = cfg.UserAgent
+	}
+	if cfg.Transport != nil {
+		merged.Transport = cfg.Transport
+	}
+	if cfg.JWKSCache != nil {
+		merged.JWKSCache = cfg.JWKSCache
+	}
+	if cfg.ClientRetry != nil {
+		// copy the retry config, the traceparent setting is written to it
+		rc := *cfg.ClientRetry
+		merged.ClientRetry = &rc
+	}
 
 	merged.EnableTraceparent = cfg.EnableTraceparent || merged.EnableTraceparent
 	return &merged
@@ -218,7 +236,7 @@ func (c *KeyFlow) mergeConfigs(cfg, currentCfg *KeyFlowConfig) *KeyFlowConfig {
 
 // configureHTTPClient configures the HTTP client
 func (c *KeyFlow) configureHTTPClient(ctx context.Context) {
-	client := &http.Client{}
+	client := &http.Client{Transport: c.config.Transport}
 	client.Timeout = DefaultClientTimeout
 	c.client = client
 }
@@ -343,7 +361,8 @@ func (c *KeyFlow) requestToken(grant, assertion string) (*http.Response, error)
 		return nil, err
 	}
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	return c.doer(&http.Client{}, req, c.config.ClientRetry)
+	setUserAgent(req, c.config.UserAgent)
+	return c.doer(&http.Client{Transport: c.config.Transport}, req, c.config.ClientRetry)
 }
 
 // parseTokenResponse parses the response from the server
@@ -381,25 +400,47 @@ func (c *KeyFlow) validateToken(token string) (bool, error) {
 
 // parseToken parses and validates a JWT token
 func (c *KeyFlow) parseToken(token string) (*jwt.Token, error) {
-	b, err := c.getJwksJSON()
+	jwks, err := c.getJwks()
 	if err != nil {
 		return nil, err
 	}
-	var jwksBytes = json.RawMessage(b)
-	jwks, err := keyfunc.NewJSON(jwksBytes)
+	return jwt.Parse(token, jwks.Keyfunc)
+}
+
+// getJwks returns the JWKS, using the configured cache if set
+func (c *KeyFlow) getJwks() (*keyfunc.JWKS, error) {
+	if c.config.JWKSCache != nil {
+		return c.config.JWKSCache.get(c.getJwksJSON)
+	}
+	b, err := c.getJwksJSON()
 	if err != nil {
 		return nil, err
 	}
+	var jwksBytes = json.RawMessage(b)
+	return keyfunc.NewJSON(jwksBytes)
+}
 
-	return jwt.Parse(token, jwks.Keyfunc)
+// CheckJWKS verifies that the JWKS used for validating tokens is reachable and can be parsed
+func (c *KeyFlow) CheckJWKS(ctx context.Context) error {
+	b, err := c.getJwksJSONWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = keyfunc.NewJSON(json.RawMessage(b))
+	return err
 }
 
 func (c *KeyFlow) getJwksJSON() ([]byte, error) {
-	req, err := http.NewRequest("GET", jsksAPI.Get(), nil)
+	return c.getJwksJSONWithContext(context.Background())
+}
+
+func (c *KeyFlow) getJwksJSONWithContext(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", jsksAPI.Get(), nil)
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.doer(&http.Client{}, req, c.config.ClientRetry)
+	setUserAgent(req, c.config.UserAgent)
+	res, err := c.doer(&http.Client{Transport: c.config.Transport}, req, c.config.ClientRetry)
 	if err != nil {
 		return nil, err
 	}
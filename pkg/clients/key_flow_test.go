@@ -80,6 +80,14 @@ func TestKeyFlow_processConfig(t *testing.T) {
 	}
 }
 
+func TestKeyFlow_processConfig_ClientRetry(t *testing.T) {
+	rc := &RetryConfig{MaxRetries: 1}
+	c := &KeyFlow{}
+	c.processConfig(KeyFlowConfig{ClientRetry: rc})
+	assert.Equal(t, 1, c.config.ClientRetry.MaxRetries)
+	assert.Nil(t, rc.Traceparent, "the given retry config shouldn't be modified")
+}
+
 func TestKeyFlow_validateConfig(t *testing.T) {
 	type fields struct {
 		config *KeyFlowConfig
@@ -482,3 +490,58 @@ func TestRequestToken(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyFlow_CheckJWKS(t *testing.T) {
+	testCases := []struct {
+		name         string
+		mockResponse *http.Response
+		mockError    error
+		wantErr      bool
+	}{
+		{
+			name: "ok",
+			mockResponse: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"keys": []}`)),
+			},
+		},
+		{
+			name: "bad status",
+			mockResponse: &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Status:     http.StatusText(http.StatusServiceUnavailable),
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad body",
+			mockResponse: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`not json`)),
+			},
+			wantErr: true,
+		},
+		{
+			name:         "request error",
+			mockResponse: nil,
+			mockError:    errors.New("request error"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDoer := new(MockDoer)
+			mockDoer.On("Do", mock.Anything).Return(tc.mockResponse, tc.mockError)
+
+			c := &KeyFlow{
+				config: &KeyFlowConfig{ClientRetry: NewRetryConfig()},
+				doer:   mockDoer.Do,
+			}
+			if err := c.CheckJWKS(context.Background()); (err != nil) != tc.wantErr {
+				t.Errorf("KeyFlow.CheckJWKS() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -28,6 +28,8 @@ type TokenFlowConfig struct {
 	ServiceAccountToken string
 	ClientRetry         *RetryConfig
 	EnableTraceparent   bool
+	UserAgent           string            // Product token appended to the User-Agent, i.e. my-tool/1.0.0
+	Transport           http.RoundTripper // HTTP transport, http.DefaultTransport is used if nil
 }
 
 // GetServiceAccountEmail returns the service account email
@@ -89,6 +91,17 @@ func (c *TokenFlow) mergeConfigs(cfg, currentCfg *TokenFlowConfig) *TokenFlowCon
 	if cfg.ServiceAccountToken != "" {
 		merged.ServiceAccountToken = cfg.ServiceAccountToken
 	}
+	if cfg.UserAgent != "" {
+		merged.UserAgent = cfg.UserAgent
+	}
+	if cfg.Transport != nil {
+		merged.Transport = cfg.Transport
+	}
+	if cfg.ClientRetry != nil {
+		// copy the retry config, the traceparent setting is written to it
+		rc := *cfg.ClientRetry
+		merged.ClientRetry = &rc
+	}
 	merged.EnableTraceparent = cfg.EnableTraceparent || merged.EnableTraceparent
 	return &merged
 }
@@ -98,6 +111,9 @@ func (c *TokenFlow) configureHTTPClient(ctx context.Context) {
 	sts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: c.config.ServiceAccountToken},
 	)
+	if c.config.Transport != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: c.config.Transport})
+	}
 	o2nc := oauth2.NewClient(ctx, sts)
 	o2nc.Timeout = DefaultClientTimeout
 	c.client = o2nc
@@ -119,5 +135,6 @@ func (c *TokenFlow) Do(req *http.Request) (*http.Response, error) {
 	if c.client == nil {
 		return nil, errors.New("please run Init()")
 	}
+	setUserAgent(req, c.config.UserAgent)
 	return do(c.client, req, c.config.ClientRetry)
 }
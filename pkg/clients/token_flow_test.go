@@ -65,6 +65,14 @@ func TestTokenFlow_processConfig(t *testing.T) {
 	}
 }
 
+func TestTokenFlow_processConfig_ClientRetry(t *testing.T) {
+	rc := &RetryConfig{MaxRetries: 1}
+	c := &TokenFlow{}
+	c.processConfig(TokenFlowConfig{ClientRetry: rc})
+	assert.Equal(t, 1, c.config.ClientRetry.MaxRetries)
+	assert.Nil(t, rc.Traceparent, "the given retry config shouldn't be modified")
+}
+
 func TestTokenFlow_Init(t *testing.T) {
 	type args struct {
 		ctx context.Context
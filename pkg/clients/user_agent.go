@@ -0,0 +1,54 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// SDKName is the product name used in the User-Agent header
+	SDKName = "community-stackit-go-client"
+
+	// UserAgentHeader is the name of the User-Agent header
+	UserAgentHeader = "User-Agent"
+)
+
+// UserAgent returns the User-Agent value for a given service and API version
+// i.e. community-stackit-go-client/v1.0.0 (kubernetes/v1.1)
+// when service is empty, the comment is omitted
+func UserAgent(service, apiVersion string) string {
+	ua := fmt.Sprintf("%s/%s", SDKName, Version)
+	if service == "" {
+		return ua
+	}
+	if apiVersion == "" {
+		return fmt.Sprintf("%s (%s)", ua, service)
+	}
+	return fmt.Sprintf("%s (%s/%s)", ua, service, apiVersion)
+}
+
+// AppendUserAgent returns the User-Agent value with ua appended to the value set by the caller
+// values already identifying the SDK are returned as they are
+func AppendUserAgent(existing, ua string) string {
+	switch {
+	case existing == "":
+		return ua
+	case strings.Contains(existing, SDKName+"/"):
+		return existing
+	}
+	return fmt.Sprintf("%s %s", existing, ua)
+}
+
+// setUserAgent adds the SDK User-Agent to the request unless it was already set by the service client
+// and appends the caller's product token, if one is configured
+func setUserAgent(req *http.Request, product string) {
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	ua := AppendUserAgent(req.Header.Get(UserAgentHeader), UserAgent("", ""))
+	if product != "" && !strings.HasSuffix(ua, " "+product) {
+		ua = fmt.Sprintf("%s %s", ua, product)
+	}
+	req.Header.Set(UserAgentHeader, ua)
+}
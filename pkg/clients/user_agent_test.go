@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		name       string
+		service    string
+		apiVersion string
+		want       string
+	}{
+		{"sdk only", "", "", SDKName + "/" + Version},
+		{"service", "kubernetes", "", SDKName + "/" + Version + " (kubernetes)"},
+		{"service and version", "kubernetes", "v1.1", SDKName + "/" + Version + " (kubernetes/v1.1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UserAgent(tt.service, tt.apiVersion))
+		})
+	}
+}
+
+func TestAppendUserAgent(t *testing.T) {
+	ua := UserAgent("kubernetes", "v1.1")
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{"empty", "", ua},
+		{"caller user agent", "my-app/2.0", "my-app/2.0 " + ua},
+		{"already identified", "my-app/2.0 " + ua, "my-app/2.0 " + ua},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AppendUserAgent(tt.existing, ua))
+		})
+	}
+}
+
+func Test_setUserAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		product  string
+		want     string
+	}{
+		{"default", "", "", UserAgent("", "")},
+		{"keep caller user agent", "my-app/2.0", "", "my-app/2.0 " + UserAgent("", "")},
+		{"keep caller and service user agent", "my-app/2.0 " + UserAgent("argus", "v1.0"), "", "my-app/2.0 " + UserAgent("argus", "v1.0")},
+		{"keep service user agent", UserAgent("argus", "v1.0"), "", UserAgent("argus", "v1.0")},
+		{"append product", UserAgent("argus", "v1.0"), "my-tool/1.0", UserAgent("argus", "v1.0") + " my-tool/1.0"},
+		{"append product once", UserAgent("argus", "v1.0") + " my-tool/1.0", "my-tool/1.0", UserAgent("argus", "v1.0") + " my-tool/1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			if tt.existing != "" {
+				req.Header.Set(UserAgentHeader, tt.existing)
+			}
+			setUserAgent(req, tt.product)
+			assert.Equal(t, tt.want, req.Header.Get(UserAgentHeader))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package contracts
+
+import (
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+)
+
+// userAgentClient wraps a client flow and identifies
+// the calling service in the User-Agent header
+type userAgentClient struct {
+	BaseClientInterface
+	userAgent string
+}
+
+// WithUserAgent wraps a given client so that requests carry a User-Agent
+// identifying the SDK version, service and API version
+// i.e. community-stackit-go-client/v1.0.0 (kubernetes/v1.1)
+func WithUserAgent(c BaseClientInterface, service, apiVersion string) BaseClientInterface {
+	if c == nil {
+		return nil
+	}
+	if v, ok := c.(*userAgentClient); ok {
+		c = v.BaseClientInterface
+	}
+	return &userAgentClient{
+		BaseClientInterface: c,
+		userAgent:           clients.UserAgent(service, apiVersion),
+	}
+}
+
+// Do adds the User-Agent to the header set by the caller, if any, and performs the request
+func (c *userAgentClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	req.Header.Set(clients.UserAgentHeader, clients.AppendUserAgent(req.Header.Get(clients.UserAgentHeader), c.userAgent))
+	return c.BaseClientInterface.Do(req)
+}
+
+// Clone creates a clone of the wrapped client
+func (c *userAgentClient) Clone() interface{} {
+	nc, ok := c.BaseClientInterface.Clone().(BaseClientInterface)
+	if !ok {
+		return nil
+	}
+	return &userAgentClient{
+		BaseClientInterface: nc,
+		userAgent:           c.userAgent,
+	}
+}
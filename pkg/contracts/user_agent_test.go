@@ -0,0 +1,51 @@
+package contracts
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingClient records the User-Agent of performed requests
+type recordingClient struct {
+	userAgent string
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	c.userAgent = req.Header.Get(clients.UserAgentHeader)
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func (c *recordingClient) GetServiceAccountEmail() string { return "" }
+
+func (c *recordingClient) Clone() interface{} { return &recordingClient{} }
+
+func TestWithUserAgent_Do(t *testing.T) {
+	ua := clients.UserAgent("kubernetes", "v1.1")
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{"set when missing", "", ua},
+		{"append to caller user agent", "my-app/2.0", "my-app/2.0 " + ua},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &recordingClient{}
+			c := WithUserAgent(rc, "kubernetes", "v1.1")
+			req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			require.NoError(t, err)
+			if tt.existing != "" {
+				req.Header.Set(clients.UserAgentHeader, tt.existing)
+			}
+			res, err := c.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			assert.Equal(t, tt.want, rc.userAgent)
+		})
+	}
+}
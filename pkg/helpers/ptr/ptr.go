@@ -0,0 +1,21 @@
+// Package ptr helps with the optional fields of the request and response models, which are pointers
+package ptr
+
+// Of returns a pointer to v
+func Of[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value p points to, or the zero value of T if p is nil
+func Deref[T any](p *T) T {
+	var zero T
+	return DerefOr(p, zero)
+}
+
+// DerefOr returns the value p points to, or def if p is nil
+func DerefOr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
@@ -0,0 +1,27 @@
+package ptr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOf(t *testing.T) {
+	s := "value"
+	p := Of(s)
+	assert.Equal(t, "value", *p)
+	assert.NotSame(t, &s, p)
+	assert.Equal(t, 3, *Of(3))
+}
+
+func TestDeref(t *testing.T) {
+	assert.Equal(t, "value", Deref(Of("value")))
+	assert.Equal(t, "", Deref[string](nil))
+	assert.Nil(t, Deref[[]string](nil))
+}
+
+func TestDerefOr(t *testing.T) {
+	assert.Equal(t, 1, DerefOr(Of(1), 2))
+	assert.Equal(t, 2, DerefOr(nil, 2))
+	assert.Equal(t, false, DerefOr(Of(false), true))
+}
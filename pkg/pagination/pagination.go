@@ -0,0 +1,105 @@
+package pagination
+
+import "iter"
+
+// OffsetFn fetches the page starting at the given offset
+// it returns the items of the page and the page size reported by the server, 0 if unknown
+type OffsetFn[T any] func(offset int) (items []T, limit int, err error)
+
+// PageFn fetches the page with the given number, the first page is 1
+// it returns the items of the page and the total number of pages, 0 if unknown
+type PageFn[T any] func(page int) (items []T, totalPages int, err error)
+
+// CursorFn fetches the page at the given cursor, the first page is fetched with an empty cursor
+// it returns the items of the page and the cursor of the next page, empty if there are no more pages
+type CursorFn[T any] func(cursor string) (items []T, next string, err error)
+
+// Offset returns an iterator over all items of an offset paginated API
+// iteration stops after an empty page or a page with less items than the page size
+func Offset[T any](fetch OffsetFn[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := 0
+		for {
+			items, limit, err := fetch(offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yieldAll(items, yield) {
+				return
+			}
+			if len(items) == 0 || len(items) < limit {
+				return
+			}
+			offset += len(items)
+		}
+	}
+}
+
+// Page returns an iterator over all items of a page numbered API
+// iteration stops after an empty page or the last page
+func Page[T any](fetch PageFn[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 1; ; page++ {
+			items, total, err := fetch(page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yieldAll(items, yield) {
+				return
+			}
+			if len(items) == 0 || (total > 0 && page >= total) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor returns an iterator over all items of a cursor paginated API
+// iteration stops after an empty page or when no new cursor is returned
+func Cursor[T any](fetch CursorFn[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := ""
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yieldAll(items, yield) {
+				return
+			}
+			if len(items) == 0 || next == "" || next == cursor {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// CollectAll returns all items of seq
+// on error, the items collected so far are returned with the error
+func CollectAll[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	res := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return res, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// yieldAll yields the items of a page and reports whether iteration should continue
+func yieldAll[T any](items []T, yield func(T, error) bool) bool {
+	for _, item := range items {
+		if !yield(item, nil) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var items = []int{1, 2, 3, 4, 5, 6, 7}
+
+// offsetPages serves items in pages of 3
+func offsetPages(calls *int) OffsetFn[int] {
+	return func(offset int) ([]int, int, error) {
+		*calls++
+		end := offset + 3
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], 3, nil
+	}
+}
+
+func TestOffset(t *testing.T) {
+	calls := 0
+	res, err := CollectAll(Offset(offsetPages(&calls)))
+	assert.NoError(t, err)
+	assert.Equal(t, items, res)
+	assert.Equal(t, 3, calls)
+}
+
+func TestOffset_Break(t *testing.T) {
+	calls := 0
+	res := []int{}
+	for i, err := range Offset(offsetPages(&calls)) {
+		if !assert.NoError(t, err) {
+			return
+		}
+		if i == 4 {
+			break
+		}
+		res = append(res, i)
+	}
+	assert.Equal(t, []int{1, 2, 3}, res)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		wantCalls int
+	}{
+		{"total pages known", 3, 3},
+		{"total pages unknown", 0, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			res, err := CollectAll(Page(func(page int) ([]int, int, error) {
+				calls++
+				start := (page - 1) * 3
+				if start >= len(items) {
+					return nil, tt.total, nil
+				}
+				end := start + 3
+				if end > len(items) {
+					end = len(items)
+				}
+				return items[start:end], tt.total, nil
+			}))
+			assert.NoError(t, err)
+			assert.Equal(t, items, res)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		"":  {[]int{1, 2}, "a"},
+		"a": {[]int{3, 4}, "b"},
+		"b": {[]int{5}, ""},
+	}
+	res, err := CollectAll(Cursor(func(cursor string) ([]int, string, error) {
+		p := pages[cursor]
+		return p.items, p.next, nil
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, res)
+}
+
+func TestCollectAll_Error(t *testing.T) {
+	failure := errors.New("request failed")
+	res, err := CollectAll(Cursor(func(cursor string) ([]int, string, error) {
+		if cursor == "a" {
+			return nil, "", failure
+		}
+		return []int{1, 2}, "a", nil
+	}))
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, []int{1, 2}, res)
+}
@@ -0,0 +1,254 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultIdleTimeout = 30 * time.Minute
+	DefaultConcurrency = 5
+)
+
+// Config is the client pool config
+type Config struct {
+	// Transport is the HTTP transport shared by all pooled clients
+	// a clone of http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+
+	// JWKSCache is the JWKS cache shared by all pooled key flow clients
+	// a new cache is created if nil
+	JWKSCache *clients.JWKSCache
+
+	// IdleTimeout is the duration after which an unused client is evicted
+	// evicted clients are recreated on the next Get
+	IdleTimeout time.Duration
+}
+
+// ClientPool lazily creates and caches clients for multiple accounts
+// all clients share the same HTTP transport and JWKS cache
+type ClientPool struct {
+	mu        sync.Mutex
+	config    Config
+	accounts  map[string]account
+	entries   map[string]*entry
+	transport http.RoundTripper
+	jwks      *clients.JWKSCache
+}
+
+// account holds the flow config of a registered account
+// exactly one of key or token is set
+type account struct {
+	key   *clients.KeyFlowConfig
+	token *clients.TokenFlowConfig
+}
+
+// entry is an initialized client
+type entry struct {
+	services *services.Services
+	lastUsed time.Time
+}
+
+// New returns a new client pool
+func New(cfg ...Config) *ClientPool {
+	c := Config{}
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	if c.IdleTimeout == 0 {
+		c.IdleTimeout = DefaultIdleTimeout
+	}
+	p := &ClientPool{
+		config:    c,
+		accounts:  map[string]account{},
+		entries:   map[string]*entry{},
+		transport: c.Transport,
+		jwks:      c.JWKSCache,
+	}
+	if p.transport == nil {
+		p.transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	if p.jwks == nil {
+		p.jwks = clients.NewJWKSCache(0)
+	}
+	return p
+}
+
+// AddKeyFlow registers an account authenticating with the key flow
+// an existing account with the same key is replaced
+func (p *ClientPool) AddKeyFlow(key string, cfg clients.KeyFlowConfig) {
+	p.add(key, account{key: &cfg})
+}
+
+// AddTokenFlow registers an account authenticating with the token flow
+// an existing account with the same key is replaced
+func (p *ClientPool) AddTokenFlow(key string, cfg clients.TokenFlowConfig) {
+	p.add(key, account{token: &cfg})
+}
+
+func (p *ClientPool) add(key string, a account) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.accounts[key] = a
+	delete(p.entries, key)
+}
+
+// Remove removes an account from the pool
+func (p *ClientPool) Remove(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.accounts, key)
+	delete(p.entries, key)
+}
+
+// Keys returns the sorted keys of all registered accounts
+func (p *ClientPool) Keys() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	keys := make([]string, 0, len(p.accounts))
+	for k := range p.accounts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Len returns the number of initialized clients
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.entries)
+}
+
+// Get returns the services of a given account
+// the client is initialized on first use
+func (p *ClientPool) Get(ctx context.Context, key string) (*services.Services, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	p.evictIdle(now)
+
+	if e, ok := p.entries[key]; ok {
+		e.lastUsed = now
+		return e.services, nil
+	}
+
+	a, ok := p.accounts[key]
+	if !ok {
+		return nil, fmt.Errorf("account '%s' is not registered", key)
+	}
+	s, err := p.init(ctx, a)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize client for account '%s'", key)
+	}
+	p.entries[key] = &entry{services: s, lastUsed: now}
+	return s, nil
+}
+
+// EvictIdle removes clients that weren't used within the idle timeout
+func (p *ClientPool) EvictIdle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictIdle(time.Now())
+}
+
+func (p *ClientPool) evictIdle(now time.Time) {
+	for k, e := range p.entries {
+		if now.Sub(e.lastUsed) >= p.config.IdleTimeout {
+			delete(p.entries, k)
+		}
+	}
+}
+
+// init initializes the client of a given account
+// injecting the shared transport and JWKS cache if not set
+func (p *ClientPool) init(ctx context.Context, a account) (*services.Services, error) {
+	if a.key != nil {
+		cfg := *a.key
+		if cfg.Transport == nil {
+			cfg.Transport = p.transport
+		}
+		if cfg.JWKSCache == nil {
+			cfg.JWKSCache = p.jwks
+		}
+		c := &clients.KeyFlow{}
+		if err := c.Init(ctx, cfg); err != nil {
+			return nil, err
+		}
+		return services.Init(c)
+	}
+
+	cfg := *a.token
+	if cfg.Transport == nil {
+		cfg.Transport = p.transport
+	}
+	c := &clients.TokenFlow{}
+	if err := c.Init(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return services.Init(c)
+}
+
+// Result is the outcome of running an operation for a single account
+type Result[T any] struct {
+	Key   string
+	Value T
+	Err   error
+}
+
+// Run runs fn for every registered account with at most concurrency operations in parallel
+// results are sorted by account key
+// if concurrency is lower than 1, DefaultConcurrency is used
+func Run[T any](ctx context.Context, p *ClientPool, concurrency int, fn func(ctx context.Context, key string, s *services.Services) (T, error)) []Result[T] {
+	if concurrency < 1 {
+		concurrency = DefaultConcurrency
+	}
+	keys := p.Keys()
+	res := make([]Result[T], len(keys))
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+
+	for i, key := range keys {
+		res[i].Key = key
+		wg.Add(1)
+		go func(r *Result[T]) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			s, err := p.Get(ctx, r.Key)
+			if err != nil {
+				r.Err = err
+				return
+			}
+			r.Value, r.Err = fn(ctx, r.Key, s)
+		}(&res[i])
+	}
+	wg.Wait()
+	return res
+}
+
+// Errors returns the errors of all failed results
+func Errors[T any](results []Result[T]) []error {
+	errs := []error{}
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, errors.Wrapf(r.Err, "account '%s'", r.Key))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,92 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPool_Get(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+	p.AddTokenFlow("b", clients.TokenFlowConfig{ServiceAccountEmail: "b@sa.stackit.cloud", ServiceAccountToken: "token-b"})
+	p.AddTokenFlow("a", clients.TokenFlowConfig{ServiceAccountEmail: "a@sa.stackit.cloud", ServiceAccountToken: "token-a"})
+
+	assert.Equal(t, []string{"a", "b"}, p.Keys())
+	assert.Equal(t, 0, p.Len())
+
+	s1, err := p.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, "a@sa.stackit.cloud", s1.Client.GetServiceAccountEmail())
+	tf, ok := s1.Client.(*clients.TokenFlow)
+	assert.True(t, ok)
+	assert.Equal(t, p.transport, tf.GetConfig().Transport)
+
+	s2, err := p.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Same(t, s1, s2)
+	assert.Equal(t, 1, p.Len())
+
+	_, err = p.Get(ctx, "missing")
+	assert.Error(t, err)
+
+	p.Remove("a")
+	assert.Equal(t, []string{"b"}, p.Keys())
+	assert.Equal(t, 0, p.Len())
+}
+
+func TestClientPool_EvictIdle(t *testing.T) {
+	ctx := context.Background()
+	p := New(Config{IdleTimeout: time.Millisecond})
+	p.AddTokenFlow("a", clients.TokenFlowConfig{ServiceAccountEmail: "a@sa.stackit.cloud", ServiceAccountToken: "token-a"})
+
+	s1, err := p.Get(ctx, "a")
+	assert.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	p.EvictIdle()
+	assert.Equal(t, 0, p.Len())
+
+	s2, err := p.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.NotSame(t, s1, s2)
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+	for _, k := range []string{"c", "a", "b", "d"} {
+		p.AddTokenFlow(k, clients.TokenFlowConfig{ServiceAccountEmail: k + "@sa.stackit.cloud", ServiceAccountToken: "token-" + k})
+	}
+
+	var running, max int32
+	res := Run(ctx, p, 2, func(ctx context.Context, key string, s *services.Services) (string, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if key == "b" {
+			return "", errors.New("failed")
+		}
+		return s.Client.GetServiceAccountEmail(), nil
+	})
+
+	assert.LessOrEqual(t, max, int32(2))
+	assert.Len(t, res, 4)
+	for i, k := range []string{"a", "b", "c", "d"} {
+		assert.Equal(t, k, res[i].Key)
+	}
+	assert.Equal(t, "a@sa.stackit.cloud", res[0].Value)
+	assert.Error(t, res[1].Err)
+	assert.Len(t, Errors(res), 1)
+}
@@ -0,0 +1,174 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package alertconfig
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	ListFunc                    func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ListResponse, error)
+	UpdateWithBodyFunc          func(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	UpdateFunc                  func(ctx context.Context, projectID string, instanceID string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	ReceiversListFunc           func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ReceiversListResponse, error)
+	ReceiversCreateWithBodyFunc func(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiversCreateResponse, error)
+	ReceiversCreateFunc         func(ctx context.Context, projectID string, instanceID string, body ReceiversCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiversCreateResponse, error)
+	ReceiversDeleteFunc         func(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*ReceiversDeleteResponse, error)
+	ReceiversReadFunc           func(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*ReceiversReadResponse, error)
+	ReceiversUpdateWithBodyFunc func(ctx context.Context, projectID string, instanceID string, receiver string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiversUpdateResponse, error)
+	ReceiversUpdateFunc         func(ctx context.Context, projectID string, instanceID string, receiver string, body ReceiversUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiversUpdateResponse, error)
+	RoutesListFunc              func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*RoutesListResponse, error)
+	RoutesCreateWithBodyFunc    func(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RoutesCreateResponse, error)
+	RoutesCreateFunc            func(ctx context.Context, projectID string, instanceID string, body RoutesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*RoutesCreateResponse, error)
+	RoutesDeleteFunc            func(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*RoutesDeleteResponse, error)
+	RoutesReadFunc              func(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*RoutesReadResponse, error)
+	RoutesUpdateWithBodyFunc    func(ctx context.Context, projectID string, instanceID string, receiver string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RoutesUpdateResponse, error)
+	RoutesUpdateFunc            func(ctx context.Context, projectID string, instanceID string, receiver string, body RoutesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*RoutesUpdateResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// List calls ListFunc
+func (f *Fake) List(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ListResponse, error) {
+	if f.ListFunc == nil {
+		return nil, notFaked("List")
+	}
+	return f.ListFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// UpdateWithBody calls UpdateWithBodyFunc
+func (f *Fake) UpdateWithBody(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateWithBodyFunc == nil {
+		return nil, notFaked("UpdateWithBody")
+	}
+	return f.UpdateWithBodyFunc(ctx, projectID, instanceID, contentType, body, reqEditors...)
+}
+
+// Update calls UpdateFunc
+func (f *Fake) Update(ctx context.Context, projectID string, instanceID string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateFunc == nil {
+		return nil, notFaked("Update")
+	}
+	return f.UpdateFunc(ctx, projectID, instanceID, body, reqEditors...)
+}
+
+// ReceiversList calls ReceiversListFunc
+func (f *Fake) ReceiversList(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ReceiversListResponse, error) {
+	if f.ReceiversListFunc == nil {
+		return nil, notFaked("ReceiversList")
+	}
+	return f.ReceiversListFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// ReceiversCreateWithBody calls ReceiversCreateWithBodyFunc
+func (f *Fake) ReceiversCreateWithBody(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiversCreateResponse, error) {
+	if f.ReceiversCreateWithBodyFunc == nil {
+		return nil, notFaked("ReceiversCreateWithBody")
+	}
+	return f.ReceiversCreateWithBodyFunc(ctx, projectID, instanceID, contentType, body, reqEditors...)
+}
+
+// ReceiversCreate calls ReceiversCreateFunc
+func (f *Fake) ReceiversCreate(ctx context.Context, projectID string, instanceID string, body ReceiversCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiversCreateResponse, error) {
+	if f.ReceiversCreateFunc == nil {
+		return nil, notFaked("ReceiversCreate")
+	}
+	return f.ReceiversCreateFunc(ctx, projectID, instanceID, body, reqEditors...)
+}
+
+// ReceiversDelete calls ReceiversDeleteFunc
+func (f *Fake) ReceiversDelete(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*ReceiversDeleteResponse, error) {
+	if f.ReceiversDeleteFunc == nil {
+		return nil, notFaked("ReceiversDelete")
+	}
+	return f.ReceiversDeleteFunc(ctx, projectID, instanceID, receiver, reqEditors...)
+}
+
+// ReceiversRead calls ReceiversReadFunc
+func (f *Fake) ReceiversRead(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*ReceiversReadResponse, error) {
+	if f.ReceiversReadFunc == nil {
+		return nil, notFaked("ReceiversRead")
+	}
+	return f.ReceiversReadFunc(ctx, projectID, instanceID, receiver, reqEditors...)
+}
+
+// ReceiversUpdateWithBody calls ReceiversUpdateWithBodyFunc
+func (f *Fake) ReceiversUpdateWithBody(ctx context.Context, projectID string, instanceID string, receiver string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiversUpdateResponse, error) {
+	if f.ReceiversUpdateWithBodyFunc == nil {
+		return nil, notFaked("ReceiversUpdateWithBody")
+	}
+	return f.ReceiversUpdateWithBodyFunc(ctx, projectID, instanceID, receiver, contentType, body, reqEditors...)
+}
+
+// ReceiversUpdate calls ReceiversUpdateFunc
+func (f *Fake) ReceiversUpdate(ctx context.Context, projectID string, instanceID string, receiver string, body ReceiversUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiversUpdateResponse, error) {
+	if f.ReceiversUpdateFunc == nil {
+		return nil, notFaked("ReceiversUpdate")
+	}
+	return f.ReceiversUpdateFunc(ctx, projectID, instanceID, receiver, body, reqEditors...)
+}
+
+// RoutesList calls RoutesListFunc
+func (f *Fake) RoutesList(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*RoutesListResponse, error) {
+	if f.RoutesListFunc == nil {
+		return nil, notFaked("RoutesList")
+	}
+	return f.RoutesListFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// RoutesCreateWithBody calls RoutesCreateWithBodyFunc
+func (f *Fake) RoutesCreateWithBody(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RoutesCreateResponse, error) {
+	if f.RoutesCreateWithBodyFunc == nil {
+		return nil, notFaked("RoutesCreateWithBody")
+	}
+	return f.RoutesCreateWithBodyFunc(ctx, projectID, instanceID, contentType, body, reqEditors...)
+}
+
+// RoutesCreate calls RoutesCreateFunc
+func (f *Fake) RoutesCreate(ctx context.Context, projectID string, instanceID string, body RoutesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*RoutesCreateResponse, error) {
+	if f.RoutesCreateFunc == nil {
+		return nil, notFaked("RoutesCreate")
+	}
+	return f.RoutesCreateFunc(ctx, projectID, instanceID, body, reqEditors...)
+}
+
+// RoutesDelete calls RoutesDeleteFunc
+func (f *Fake) RoutesDelete(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*RoutesDeleteResponse, error) {
+	if f.RoutesDeleteFunc == nil {
+		return nil, notFaked("RoutesDelete")
+	}
+	return f.RoutesDeleteFunc(ctx, projectID, instanceID, receiver, reqEditors...)
+}
+
+// RoutesRead calls RoutesReadFunc
+func (f *Fake) RoutesRead(ctx context.Context, projectID string, instanceID string, receiver string, reqEditors ...RequestEditorFn) (*RoutesReadResponse, error) {
+	if f.RoutesReadFunc == nil {
+		return nil, notFaked("RoutesRead")
+	}
+	return f.RoutesReadFunc(ctx, projectID, instanceID, receiver, reqEditors...)
+}
+
+// RoutesUpdateWithBody calls RoutesUpdateWithBodyFunc
+func (f *Fake) RoutesUpdateWithBody(ctx context.Context, projectID string, instanceID string, receiver string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RoutesUpdateResponse, error) {
+	if f.RoutesUpdateWithBodyFunc == nil {
+		return nil, notFaked("RoutesUpdateWithBody")
+	}
+	return f.RoutesUpdateWithBodyFunc(ctx, projectID, instanceID, receiver, contentType, body, reqEditors...)
+}
+
+// RoutesUpdate calls RoutesUpdateFunc
+func (f *Fake) RoutesUpdate(ctx context.Context, projectID string, instanceID string, receiver string, body RoutesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*RoutesUpdateResponse, error) {
+	if f.RoutesUpdateFunc == nil {
+		return nil, notFaked("RoutesUpdate")
+	}
+	return f.RoutesUpdateFunc(ctx, projectID, instanceID, receiver, body, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
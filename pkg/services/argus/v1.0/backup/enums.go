@@ -0,0 +1,175 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package backup
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of CreateParamsBackupTarget
+func (CreateParamsBackupTarget) Values() []CreateParamsBackupTarget {
+	return []CreateParamsBackupTarget{
+		ALERT_CONFIG,
+		ALERT_RULES,
+		GRAFANA,
+		SCRAPE_CONFIG,
+	}
+}
+
+// IsValid reports whether e is one of the values of CreateParamsBackupTarget
+func (e CreateParamsBackupTarget) IsValid() bool {
+	switch e {
+	case ALERT_CONFIG, ALERT_RULES, GRAFANA, SCRAPE_CONFIG:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CreateParamsBackupTarget) String() string {
+	return string(e)
+}
+
+// ParseCreateParamsBackupTarget returns s as CreateParamsBackupTarget, or a *validate.EnumError if s isn't one of its values
+func ParseCreateParamsBackupTarget(s string) (CreateParamsBackupTarget, error) {
+	return validate.ParseEnum[CreateParamsBackupTarget](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CreateParamsBackupTarget) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ListParamsBackupTarget
+func (ListParamsBackupTarget) Values() []ListParamsBackupTarget {
+	return []ListParamsBackupTarget{
+		LIST_PARAMS_BACKUP_TARGET_ALERT_CONFIG,
+		LIST_PARAMS_BACKUP_TARGET_ALERT_RULES,
+		LIST_PARAMS_BACKUP_TARGET_GRAFANA,
+		LIST_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG,
+	}
+}
+
+// IsValid reports whether e is one of the values of ListParamsBackupTarget
+func (e ListParamsBackupTarget) IsValid() bool {
+	switch e {
+	case LIST_PARAMS_BACKUP_TARGET_ALERT_CONFIG, LIST_PARAMS_BACKUP_TARGET_ALERT_RULES, LIST_PARAMS_BACKUP_TARGET_GRAFANA, LIST_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ListParamsBackupTarget) String() string {
+	return string(e)
+}
+
+// ParseListParamsBackupTarget returns s as ListParamsBackupTarget, or a *validate.EnumError if s isn't one of its values
+func ParseListParamsBackupTarget(s string) (ListParamsBackupTarget, error) {
+	return validate.ParseEnum[ListParamsBackupTarget](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ListParamsBackupTarget) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of RestoresCreateParamsRestoreTarget
+func (RestoresCreateParamsRestoreTarget) Values() []RestoresCreateParamsRestoreTarget {
+	return []RestoresCreateParamsRestoreTarget{
+		RESTORES_CREATE_PARAMS_RESTORE_TARGET_ALERT_CONFIG,
+		RESTORES_CREATE_PARAMS_RESTORE_TARGET_ALERT_RULES,
+		RESTORES_CREATE_PARAMS_RESTORE_TARGET_GRAFANA,
+		RESTORES_CREATE_PARAMS_RESTORE_TARGET_SCRAPE_CONFIG,
+	}
+}
+
+// IsValid reports whether e is one of the values of RestoresCreateParamsRestoreTarget
+func (e RestoresCreateParamsRestoreTarget) IsValid() bool {
+	switch e {
+	case RESTORES_CREATE_PARAMS_RESTORE_TARGET_ALERT_CONFIG, RESTORES_CREATE_PARAMS_RESTORE_TARGET_ALERT_RULES, RESTORES_CREATE_PARAMS_RESTORE_TARGET_GRAFANA, RESTORES_CREATE_PARAMS_RESTORE_TARGET_SCRAPE_CONFIG:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e RestoresCreateParamsRestoreTarget) String() string {
+	return string(e)
+}
+
+// ParseRestoresCreateParamsRestoreTarget returns s as RestoresCreateParamsRestoreTarget, or a *validate.EnumError if s isn't one of its values
+func ParseRestoresCreateParamsRestoreTarget(s string) (RestoresCreateParamsRestoreTarget, error) {
+	return validate.ParseEnum[RestoresCreateParamsRestoreTarget](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *RestoresCreateParamsRestoreTarget) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of SchedulesCreateParamsBackupTarget
+func (SchedulesCreateParamsBackupTarget) Values() []SchedulesCreateParamsBackupTarget {
+	return []SchedulesCreateParamsBackupTarget{
+		SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_ALERT_CONFIG,
+		SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_ALERT_RULES,
+		SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_GRAFANA,
+		SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG,
+	}
+}
+
+// IsValid reports whether e is one of the values of SchedulesCreateParamsBackupTarget
+func (e SchedulesCreateParamsBackupTarget) IsValid() bool {
+	switch e {
+	case SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_ALERT_CONFIG, SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_ALERT_RULES, SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_GRAFANA, SCHEDULES_CREATE_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e SchedulesCreateParamsBackupTarget) String() string {
+	return string(e)
+}
+
+// ParseSchedulesCreateParamsBackupTarget returns s as SchedulesCreateParamsBackupTarget, or a *validate.EnumError if s isn't one of its values
+func ParseSchedulesCreateParamsBackupTarget(s string) (SchedulesCreateParamsBackupTarget, error) {
+	return validate.ParseEnum[SchedulesCreateParamsBackupTarget](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *SchedulesCreateParamsBackupTarget) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of SchedulesListParamsBackupTarget
+func (SchedulesListParamsBackupTarget) Values() []SchedulesListParamsBackupTarget {
+	return []SchedulesListParamsBackupTarget{
+		SCHEDULES_LIST_PARAMS_BACKUP_TARGET_ALERT_CONFIG,
+		SCHEDULES_LIST_PARAMS_BACKUP_TARGET_ALERT_RULES,
+		SCHEDULES_LIST_PARAMS_BACKUP_TARGET_GRAFANA,
+		SCHEDULES_LIST_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG,
+	}
+}
+
+// IsValid reports whether e is one of the values of SchedulesListParamsBackupTarget
+func (e SchedulesListParamsBackupTarget) IsValid() bool {
+	switch e {
+	case SCHEDULES_LIST_PARAMS_BACKUP_TARGET_ALERT_CONFIG, SCHEDULES_LIST_PARAMS_BACKUP_TARGET_ALERT_RULES, SCHEDULES_LIST_PARAMS_BACKUP_TARGET_GRAFANA, SCHEDULES_LIST_PARAMS_BACKUP_TARGET_SCRAPE_CONFIG:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e SchedulesListParamsBackupTarget) String() string {
+	return string(e)
+}
+
+// ParseSchedulesListParamsBackupTarget returns s as SchedulesListParamsBackupTarget, or a *validate.EnumError if s isn't one of its values
+func ParseSchedulesListParamsBackupTarget(s string) (SchedulesListParamsBackupTarget, error) {
+	return validate.ParseEnum[SchedulesListParamsBackupTarget](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *SchedulesListParamsBackupTarget) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,67 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kind of the operation described by the wait handler
+const kindRestore = "backup_restore"
+
+// restoreGraceReads is the number of consecutive reads of a succeeded or failed status after which
+// the status is accepted without having observed the instance updating, i.e. if the restore was applied between two reads
+const restoreGraceReads = 6
+
+// WaitHandler will wait for the restore to be applied
+// restores have no status, the handler waits for the instance to be updating and then ready again
+// a status observed before the instance was updating may belong to a previous operation
+// and is only accepted once it was read restoreGraceReads times in a row
+// returned value is the last observed *instances.ProjectInstanceUI or nil
+func (*RestoresCreateResponse) WaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*instances.ProjectInstanceUI] {
+	seenUpdating := false
+	settledReads := 0
+	return wait.NewHandler(func() (res *instances.ProjectInstanceUI, done bool, err error) {
+		s, err := c.Get(ctx, projectID, instanceID)
+		if err = validate.Response(s, err, "JSON200"); err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		switch s.JSON200.Status {
+		case instances.PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED, instances.PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED, instances.PROJECT_INSTANCE_UI_STATUS_UPDATE_FAILED:
+			settledReads++
+		default:
+			seenUpdating = seenUpdating || s.JSON200.Status == instances.PROJECT_INSTANCE_UI_STATUS_UPDATING
+			settledReads = 0
+			return s.JSON200, false, nil
+		}
+		if !seenUpdating && settledReads < restoreGraceReads {
+			return s.JSON200, false, nil
+		}
+		if s.JSON200.Status == instances.PROJECT_INSTANCE_UI_STATUS_UPDATE_FAILED {
+			return s.JSON200, false, &wait.TerminalError{State: string(s.JSON200.Status)}
+		}
+		return s.JSON200, true, nil
+	}).SetStateFunc(instanceState).
+		Describe("argus", kindRestore, string(instances.PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// instanceState returns the status of an observed instance
+func instanceState(res *instances.ProjectInstanceUI) string {
+	if res == nil {
+		return ""
+	}
+	return string(res.Status)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instances.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindRestore:
+		return wait.Any((*RestoresCreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
@@ -0,0 +1,83 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package instances
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of ProjectInstanceFullStatus
+func (ProjectInstanceFullStatus) Values() []ProjectInstanceFullStatus {
+	return []ProjectInstanceFullStatus{
+		PROJECT_INSTANCE_FULL_STATUS_CREATE_FAILED,
+		PROJECT_INSTANCE_FULL_STATUS_CREATE_SUCCEEDED,
+		PROJECT_INSTANCE_FULL_STATUS_CREATING,
+		PROJECT_INSTANCE_FULL_STATUS_DELETE_FAILED,
+		PROJECT_INSTANCE_FULL_STATUS_DELETE_SUCCEEDED,
+		PROJECT_INSTANCE_FULL_STATUS_DELETING,
+		PROJECT_INSTANCE_FULL_STATUS_UPDATE_FAILED,
+		PROJECT_INSTANCE_FULL_STATUS_UPDATE_SUCCEEDED,
+		PROJECT_INSTANCE_FULL_STATUS_UPDATING,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectInstanceFullStatus
+func (e ProjectInstanceFullStatus) IsValid() bool {
+	switch e {
+	case PROJECT_INSTANCE_FULL_STATUS_CREATE_FAILED, PROJECT_INSTANCE_FULL_STATUS_CREATE_SUCCEEDED, PROJECT_INSTANCE_FULL_STATUS_CREATING, PROJECT_INSTANCE_FULL_STATUS_DELETE_FAILED, PROJECT_INSTANCE_FULL_STATUS_DELETE_SUCCEEDED, PROJECT_INSTANCE_FULL_STATUS_DELETING, PROJECT_INSTANCE_FULL_STATUS_UPDATE_FAILED, PROJECT_INSTANCE_FULL_STATUS_UPDATE_SUCCEEDED, PROJECT_INSTANCE_FULL_STATUS_UPDATING:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectInstanceFullStatus) String() string {
+	return string(e)
+}
+
+// ParseProjectInstanceFullStatus returns s as ProjectInstanceFullStatus, or a *validate.EnumError if s isn't one of its values
+func ParseProjectInstanceFullStatus(s string) (ProjectInstanceFullStatus, error) {
+	return validate.ParseEnum[ProjectInstanceFullStatus](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectInstanceFullStatus) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectInstanceUIStatus
+func (ProjectInstanceUIStatus) Values() []ProjectInstanceUIStatus {
+	return []ProjectInstanceUIStatus{
+		PROJECT_INSTANCE_UI_STATUS_CREATE_FAILED,
+		PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED,
+		PROJECT_INSTANCE_UI_STATUS_CREATING,
+		PROJECT_INSTANCE_UI_STATUS_DELETE_FAILED,
+		PROJECT_INSTANCE_UI_STATUS_DELETE_SUCCEEDED,
+		PROJECT_INSTANCE_UI_STATUS_DELETING,
+		PROJECT_INSTANCE_UI_STATUS_UPDATE_FAILED,
+		PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED,
+		PROJECT_INSTANCE_UI_STATUS_UPDATING,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectInstanceUIStatus
+func (e ProjectInstanceUIStatus) IsValid() bool {
+	switch e {
+	case PROJECT_INSTANCE_UI_STATUS_CREATE_FAILED, PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED, PROJECT_INSTANCE_UI_STATUS_CREATING, PROJECT_INSTANCE_UI_STATUS_DELETE_FAILED, PROJECT_INSTANCE_UI_STATUS_DELETE_SUCCEEDED, PROJECT_INSTANCE_UI_STATUS_DELETING, PROJECT_INSTANCE_UI_STATUS_UPDATE_FAILED, PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED, PROJECT_INSTANCE_UI_STATUS_UPDATING:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectInstanceUIStatus) String() string {
+	return string(e)
+}
+
+// ParseProjectInstanceUIStatus returns s as ProjectInstanceUIStatus, or a *validate.EnumError if s isn't one of its values
+func ParseProjectInstanceUIStatus(s string) (ProjectInstanceUIStatus, error) {
+	return validate.ParseEnum[ProjectInstanceUIStatus](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectInstanceUIStatus) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,192 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	ListFunc                                       func(ctx context.Context, projectID string, reqEditors ...RequestEditorFn) (*ListResponse, error)
+	CreateWithBodyFunc                             func(ctx context.Context, projectID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	CreateFunc                                     func(ctx context.Context, projectID string, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	DeleteFunc                                     func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*DeleteResponse, error)
+	GetFunc                                        func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	UpdateWithBodyFunc                             func(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	UpdateFunc                                     func(ctx context.Context, projectID string, instanceID string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	ListInstanceCredentialsFunc                    func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ListInstanceCredentialsResponse, error)
+	CredentialsCreateFunc                          func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*CredentialsCreateResponse, error)
+	CredentialsDeleteFunc                          func(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsDeleteResponse, error)
+	CredentialsReadFunc                            func(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsReadResponse, error)
+	CredentialsRemoteWriteLimitsDeleteFunc         func(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsDeleteResponse, error)
+	CredentialsRemoteWriteLimitsListFunc           func(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsListResponse, error)
+	CredentialsRemoteWriteLimitsUpdateWithBodyFunc func(ctx context.Context, projectID string, instanceID string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsUpdateResponse, error)
+	CredentialsRemoteWriteLimitsUpdateFunc         func(ctx context.Context, projectID string, instanceID string, username string, body CredentialsRemoteWriteLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsUpdateResponse, error)
+	SystemInstancesReadFunc                        func(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*SystemInstancesReadResponse, error)
+	SystemInstancesCredentialsCreateWithBodyFunc   func(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsCreateResponse, error)
+	SystemInstancesCredentialsCreateFunc           func(ctx context.Context, projectID string, instanceID string, body SystemInstancesCredentialsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsCreateResponse, error)
+	SystemInstancesCredentialsDeleteFunc           func(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsDeleteResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// List calls ListFunc
+func (f *Fake) List(ctx context.Context, projectID string, reqEditors ...RequestEditorFn) (*ListResponse, error) {
+	if f.ListFunc == nil {
+		return nil, notFaked("List")
+	}
+	return f.ListFunc(ctx, projectID, reqEditors...)
+}
+
+// CreateWithBody calls CreateWithBodyFunc
+func (f *Fake) CreateWithBody(ctx context.Context, projectID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateWithBodyFunc == nil {
+		return nil, notFaked("CreateWithBody")
+	}
+	return f.CreateWithBodyFunc(ctx, projectID, contentType, body, reqEditors...)
+}
+
+// Create calls CreateFunc
+func (f *Fake) Create(ctx context.Context, projectID string, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateFunc == nil {
+		return nil, notFaked("Create")
+	}
+	return f.CreateFunc(ctx, projectID, body, reqEditors...)
+}
+
+// Delete calls DeleteFunc
+func (f *Fake) Delete(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*DeleteResponse, error) {
+	if f.DeleteFunc == nil {
+		return nil, notFaked("Delete")
+	}
+	return f.DeleteFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// Get calls GetFunc
+func (f *Fake) Get(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	if f.GetFunc == nil {
+		return nil, notFaked("Get")
+	}
+	return f.GetFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// UpdateWithBody calls UpdateWithBodyFunc
+func (f *Fake) UpdateWithBody(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateWithBodyFunc == nil {
+		return nil, notFaked("UpdateWithBody")
+	}
+	return f.UpdateWithBodyFunc(ctx, projectID, instanceID, contentType, body, reqEditors...)
+}
+
+// Update calls UpdateFunc
+func (f *Fake) Update(ctx context.Context, projectID string, instanceID string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateFunc == nil {
+		return nil, notFaked("Update")
+	}
+	return f.UpdateFunc(ctx, projectID, instanceID, body, reqEditors...)
+}
+
+// ListInstanceCredentials calls ListInstanceCredentialsFunc
+func (f *Fake) ListInstanceCredentials(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*ListInstanceCredentialsResponse, error) {
+	if f.ListInstanceCredentialsFunc == nil {
+		return nil, notFaked("ListInstanceCredentials")
+	}
+	return f.ListInstanceCredentialsFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// CredentialsCreate calls CredentialsCreateFunc
+func (f *Fake) CredentialsCreate(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*CredentialsCreateResponse, error) {
+	if f.CredentialsCreateFunc == nil {
+		return nil, notFaked("CredentialsCreate")
+	}
+	return f.CredentialsCreateFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// CredentialsDelete calls CredentialsDeleteFunc
+func (f *Fake) CredentialsDelete(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsDeleteResponse, error) {
+	if f.CredentialsDeleteFunc == nil {
+		return nil, notFaked("CredentialsDelete")
+	}
+	return f.CredentialsDeleteFunc(ctx, projectID, instanceID, username, reqEditors...)
+}
+
+// CredentialsRead calls CredentialsReadFunc
+func (f *Fake) CredentialsRead(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsReadResponse, error) {
+	if f.CredentialsReadFunc == nil {
+		return nil, notFaked("CredentialsRead")
+	}
+	return f.CredentialsReadFunc(ctx, projectID, instanceID, username, reqEditors...)
+}
+
+// CredentialsRemoteWriteLimitsDelete calls CredentialsRemoteWriteLimitsDeleteFunc
+func (f *Fake) CredentialsRemoteWriteLimitsDelete(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsDeleteResponse, error) {
+	if f.CredentialsRemoteWriteLimitsDeleteFunc == nil {
+		return nil, notFaked("CredentialsRemoteWriteLimitsDelete")
+	}
+	return f.CredentialsRemoteWriteLimitsDeleteFunc(ctx, projectID, instanceID, username, reqEditors...)
+}
+
+// CredentialsRemoteWriteLimitsList calls CredentialsRemoteWriteLimitsListFunc
+func (f *Fake) CredentialsRemoteWriteLimitsList(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsListResponse, error) {
+	if f.CredentialsRemoteWriteLimitsListFunc == nil {
+		return nil, notFaked("CredentialsRemoteWriteLimitsList")
+	}
+	return f.CredentialsRemoteWriteLimitsListFunc(ctx, projectID, instanceID, username, reqEditors...)
+}
+
+// CredentialsRemoteWriteLimitsUpdateWithBody calls CredentialsRemoteWriteLimitsUpdateWithBodyFunc
+func (f *Fake) CredentialsRemoteWriteLimitsUpdateWithBody(ctx context.Context, projectID string, instanceID string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsUpdateResponse, error) {
+	if f.CredentialsRemoteWriteLimitsUpdateWithBodyFunc == nil {
+		return nil, notFaked("CredentialsRemoteWriteLimitsUpdateWithBody")
+	}
+	return f.CredentialsRemoteWriteLimitsUpdateWithBodyFunc(ctx, projectID, instanceID, username, contentType, body, reqEditors...)
+}
+
+// CredentialsRemoteWriteLimitsUpdate calls CredentialsRemoteWriteLimitsUpdateFunc
+func (f *Fake) CredentialsRemoteWriteLimitsUpdate(ctx context.Context, projectID string, instanceID string, username string, body CredentialsRemoteWriteLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*CredentialsRemoteWriteLimitsUpdateResponse, error) {
+	if f.CredentialsRemoteWriteLimitsUpdateFunc == nil {
+		return nil, notFaked("CredentialsRemoteWriteLimitsUpdate")
+	}
+	return f.CredentialsRemoteWriteLimitsUpdateFunc(ctx, projectID, instanceID, username, body, reqEditors...)
+}
+
+// SystemInstancesRead calls SystemInstancesReadFunc
+func (f *Fake) SystemInstancesRead(ctx context.Context, projectID string, instanceID string, reqEditors ...RequestEditorFn) (*SystemInstancesReadResponse, error) {
+	if f.SystemInstancesReadFunc == nil {
+		return nil, notFaked("SystemInstancesRead")
+	}
+	return f.SystemInstancesReadFunc(ctx, projectID, instanceID, reqEditors...)
+}
+
+// SystemInstancesCredentialsCreateWithBody calls SystemInstancesCredentialsCreateWithBodyFunc
+func (f *Fake) SystemInstancesCredentialsCreateWithBody(ctx context.Context, projectID string, instanceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsCreateResponse, error) {
+	if f.SystemInstancesCredentialsCreateWithBodyFunc == nil {
+		return nil, notFaked("SystemInstancesCredentialsCreateWithBody")
+	}
+	return f.SystemInstancesCredentialsCreateWithBodyFunc(ctx, projectID, instanceID, contentType, body, reqEditors...)
+}
+
+// SystemInstancesCredentialsCreate calls SystemInstancesCredentialsCreateFunc
+func (f *Fake) SystemInstancesCredentialsCreate(ctx context.Context, projectID string, instanceID string, body SystemInstancesCredentialsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsCreateResponse, error) {
+	if f.SystemInstancesCredentialsCreateFunc == nil {
+		return nil, notFaked("SystemInstancesCredentialsCreate")
+	}
+	return f.SystemInstancesCredentialsCreateFunc(ctx, projectID, instanceID, body, reqEditors...)
+}
+
+// SystemInstancesCredentialsDelete calls SystemInstancesCredentialsDeleteFunc
+func (f *Fake) SystemInstancesCredentialsDelete(ctx context.Context, projectID string, instanceID string, username string, reqEditors ...RequestEditorFn) (*SystemInstancesCredentialsDeleteResponse, error) {
+	if f.SystemInstancesCredentialsDeleteFunc == nil {
+		return nil, notFaked("SystemInstancesCredentialsDelete")
+	}
+	return f.SystemInstancesCredentialsDeleteFunc(ctx, projectID, instanceID, username, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
@@ -4,65 +4,43 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
 const (
-	connection_reset = "read: connection reset" // catch connection reset by peer error
-	gateway_timeout  = "Gateway Timeout"
+	kindCreate = "instances_create"
+	kindUpdate = "instances_update"
+	kindDelete = "instances_delete"
 )
 
 // Wait will wait for  creation
-// returned interface is nil or *ProjectInstanceUI
-func (*CreateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// returned value is the last observed *ProjectInstanceUI or nil
+func (*CreateResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*ProjectInstanceUI] {
+	return wait.NewHandler(func() (res *ProjectInstanceUI, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
-		if agg := validate.Response(s, err, "JSON200"); agg != nil {
-			if strings.Contains(agg.Error(), connection_reset) ||
-				strings.Contains(agg.Error(), gateway_timeout) {
-				return nil, false, nil
-			}
-			if validate.StatusEquals(s,
-				http.StatusBadGateway,
-				http.StatusGatewayTimeout,
-				http.StatusInternalServerError,
-				http.StatusForbidden,
-			) {
-				return nil, false, nil
-			}
-			return nil, false, agg
+		if err = validate.Response(s, err, "JSON200"); err != nil {
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if s.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED {
 			return s.JSON200, true, nil
 		}
 		return s.JSON200, false, nil
-	})
+	}).SetStateFunc(instanceState).SetTransientClassifier(transientErrors()).
+		Describe("argus", kindCreate, string(PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for  update
-// returned interface is nil or *ProjectInstanceUI
-func (*UpdateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
+// returned value is the last observed *ProjectInstanceUI or nil
+func (*UpdateResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*ProjectInstanceUI] {
 	seenUpdating := false
-	return wait.New(func() (res interface{}, done bool, err error) {
+	return wait.NewHandler(func() (res *ProjectInstanceUI, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
-		if agg := validate.Response(s, err, "JSON200"); agg != nil {
-			if strings.Contains(agg.Error(), connection_reset) ||
-				strings.Contains(agg.Error(), gateway_timeout) {
-				return nil, false, nil
-			}
-			if validate.StatusEquals(s,
-				http.StatusBadGateway,
-				http.StatusGatewayTimeout,
-				http.StatusInternalServerError,
-				http.StatusForbidden,
-			) {
-				return nil, false, nil
-			}
-			return nil, false, agg
+		if err = validate.Response(s, err, "JSON200"); err != nil {
+			return nil, false, wait.NewRequestError(s, err)
 		}
 
 		if s.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED ||
@@ -77,60 +55,37 @@ func (*UpdateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses,
 			// instance status to UPDATING
 			// the following code will wait for the status change for 5 minutes
 			// and continue the outer wait on change or fail
-			w := wait.New(func() (res interface{}, done bool, err error) {
+			w := wait.NewHandler(func() (res struct{}, done bool, err error) {
 				si, err := c.Get(ctx, projectID, instanceID)
-				if agg := validate.Response(si, err, "JSON200"); agg != nil {
-					if strings.Contains(agg.Error(), connection_reset) ||
-						strings.Contains(agg.Error(), gateway_timeout) {
-						return nil, false, nil
-					}
-					if validate.StatusEquals(s,
-						http.StatusBadGateway,
-						http.StatusGatewayTimeout,
-						http.StatusInternalServerError,
-						http.StatusForbidden,
-					) {
-						return nil, false, nil
-					}
-					return nil, false, agg
+				if err = validate.Response(si, err, "JSON200"); err != nil {
+					return struct{}{}, false, wait.NewRequestError(si, err)
 				}
 				if si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_UPDATING ||
 					si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED ||
 					si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_UPDATE_FAILED {
-					return nil, true, nil
+					return struct{}{}, true, nil
 				}
-				return nil, false, nil
-			})
-			_, err := w.SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+				return struct{}{}, false, nil
+			}).SetTransientClassifier(transientErrors())
+			_, err := w.SetTimeout(5 * time.Minute).Wait(ctx)
 			return nil, false, err
 		}
 		seenUpdating = true
 		return s.JSON200, false, nil
-	})
+	}).SetStateFunc(instanceState).SetTransientClassifier(transientErrors()).
+		Describe("argus", kindUpdate, string(PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for  deletion
-// returned interface is nil
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// returned value is nil, or the last observed *ProjectInstanceUI if the deletion failed
+func (*DeleteResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*ProjectInstanceUI] {
+	return wait.NewHandler(func() (res *ProjectInstanceUI, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
-		if agg := validate.Response(s, err, "JSON200"); agg != nil {
-			if strings.Contains(agg.Error(), connection_reset) ||
-				strings.Contains(agg.Error(), gateway_timeout) {
-				return nil, false, nil
-			}
-			if validate.StatusEquals(s,
-				http.StatusBadGateway,
-				http.StatusGatewayTimeout,
-				http.StatusInternalServerError,
-				http.StatusForbidden,
-			) {
-				return nil, false, nil
-			}
+		if err = validate.Response(s, err, "JSON200"); err != nil {
 			if validate.StatusEquals(s, http.StatusNotFound) {
 				return nil, true, nil
 			}
-			return nil, false, agg
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if s.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_DELETE_SUCCEEDED {
 			return nil, true, nil
@@ -143,36 +98,53 @@ func (*DeleteResponse) WaitHandler(ctx context.Context, c *ClientWithResponses,
 			// instance status to status DELETING
 			// the following code will wait for the status change for 5 minutes
 			// and continue the outer wait on change or fail
-			w := wait.New(func() (res interface{}, done bool, err error) {
+			w := wait.NewHandler(func() (res struct{}, done bool, err error) {
 				si, err := c.Get(ctx, projectID, instanceID)
-				if agg := validate.Response(si, err, "JSON200"); agg != nil {
-					if strings.Contains(agg.Error(), connection_reset) ||
-						strings.Contains(agg.Error(), gateway_timeout) {
-						return nil, false, nil
+				if err = validate.Response(si, err, "JSON200"); err != nil {
+					if validate.StatusEquals(si, http.StatusNotFound) {
+						return struct{}{}, true, nil
 					}
-					if validate.StatusEquals(s,
-						http.StatusBadGateway,
-						http.StatusGatewayTimeout,
-						http.StatusInternalServerError,
-						http.StatusForbidden,
-					) {
-						return nil, false, nil
-					}
-					if validate.StatusEquals(s, http.StatusNotFound) {
-						return nil, true, nil
-					}
-					return nil, false, agg
+					return struct{}{}, false, wait.NewRequestError(si, err)
 				}
 				if si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_DELETING ||
 					si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_DELETE_FAILED ||
 					si.JSON200.Status == PROJECT_INSTANCE_UI_STATUS_DELETE_SUCCEEDED {
-					return nil, true, nil
+					return struct{}{}, true, nil
 				}
-				return nil, false, nil
-			})
-			_, err := w.SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+				return struct{}{}, false, nil
+			}).SetTransientClassifier(transientErrors())
+			_, err := w.SetTimeout(5 * time.Minute).Wait(ctx)
 			return nil, false, err
 		}
 		return nil, false, nil
-	})
+	}).SetStateFunc(instanceState).SetTransientClassifier(transientErrors()).
+		Describe("argus", kindDelete, string(PROJECT_INSTANCE_UI_STATUS_DELETE_SUCCEEDED), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// instanceState returns the status of an observed instance
+func instanceState(res *ProjectInstanceUI) string {
+	if res == nil {
+		return ""
+	}
+	return string(res.Status)
+}
+
+// transientErrors returns the classifier of transient errors
+// forbidden is returned until permissions of a new instance are propagated
+func transientErrors() *wait.TransientClassifier {
+	return wait.NewTransientClassifier().WithStatusCodes(http.StatusForbidden)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindUpdate:
+		return wait.Any((*UpdateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
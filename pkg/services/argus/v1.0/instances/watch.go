@@ -0,0 +1,24 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the instances of a project
+// instances are keyed by ID
+func NewWatcher(c ClientWithResponsesInterface, projectID string) *watch.Watcher[ProjectInstanceFull] {
+	return watch.New(func(ctx context.Context) (map[string]ProjectInstanceFull, error) {
+		resp, err := c.List(ctx, projectID)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]ProjectInstanceFull{}
+		for _, i := range resp.JSON200.Instances {
+			items[i.ID] = i
+		}
+		return items, nil
+	})
+}
@@ -0,0 +1,193 @@
+// Code generated by internal/tools/builders. DO NOT EDIT.
+
+package scrapeconfig
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/ptr"
+
+// ScrapeConfigBuilder builds a CreateJSONBody
+type ScrapeConfigBuilder struct {
+	v CreateJSONBody
+}
+
+// NewScrapeConfigBuilder returns a builder of a CreateJSONBody with the following defaults:
+//   - HonorLabels: false as in the spec
+//   - HonorTimeStamps: false as in the spec
+//   - MetricsPath: "/metrics" as in the spec
+//   - Scheme: targets are scraped with https
+//   - ScrapeInterval: targets are scraped every 5 minutes
+//   - ScrapeTimeout: a scrape times out after 2 minutes
+func NewScrapeConfigBuilder() *ScrapeConfigBuilder {
+	b := &ScrapeConfigBuilder{}
+	b.v.HonorLabels = ptr.Of[bool](false)
+	b.v.HonorTimeStamps = ptr.Of[bool](false)
+	b.v.MetricsPath = ptr.Of[string]("/metrics")
+	b.v.Scheme = CREATE_JSON_BODY_SCHEME_HTTPS
+	b.v.ScrapeInterval = "5m"
+	b.v.ScrapeTimeout = "2m"
+	return b
+}
+
+// BasicAuth sets the BasicAuth of the CreateJSONBody
+func (b *ScrapeConfigBuilder) BasicAuth(v struct {
+	Password *string `json:"password,omitempty"`
+
+	Username *string `json:"username,omitempty"`
+}) *ScrapeConfigBuilder {
+	b.v.BasicAuth = &v
+	return b
+}
+
+// BearerToken sets the BearerToken of the CreateJSONBody
+func (b *ScrapeConfigBuilder) BearerToken(v string) *ScrapeConfigBuilder {
+	b.v.BearerToken = &v
+	return b
+}
+
+// HonorLabels sets the HonorLabels of the CreateJSONBody
+func (b *ScrapeConfigBuilder) HonorLabels(v bool) *ScrapeConfigBuilder {
+	b.v.HonorLabels = &v
+	return b
+}
+
+// HonorTimeStamps sets the HonorTimeStamps of the CreateJSONBody
+func (b *ScrapeConfigBuilder) HonorTimeStamps(v bool) *ScrapeConfigBuilder {
+	b.v.HonorTimeStamps = &v
+	return b
+}
+
+// HttpSdConfigs sets the HttpSdConfigs of the CreateJSONBody
+func (b *ScrapeConfigBuilder) HttpSdConfigs(v ...struct {
+	BasicAuth *struct {
+		Password *string `json:"password,omitempty"`
+
+		Username *string `json:"username,omitempty"`
+	} `json:"basicAuth,omitempty"`
+
+	Oauth2 *struct {
+		ClientID string `json:"clientId"`
+
+		ClientSecret string `json:"clientSecret"`
+
+		Scopes *[]string `json:"scopes,omitempty"`
+
+		TlsConfig *struct {
+			InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+		} `json:"tlsConfig,omitempty"`
+
+		TokenURL string `json:"tokenUrl"`
+	} `json:"oauth2,omitempty"`
+
+	RefreshInterval *string `json:"refreshInterval,omitempty"`
+
+	TlsConfig *struct {
+		InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+	} `json:"tlsConfig,omitempty"`
+
+	URL string `json:"url"`
+}) *ScrapeConfigBuilder {
+	b.v.HttpSdConfigs = &v
+	return b
+}
+
+// JobName sets the JobName of the CreateJSONBody
+func (b *ScrapeConfigBuilder) JobName(v string) *ScrapeConfigBuilder {
+	b.v.JobName = v
+	return b
+}
+
+// MetricsPath sets the MetricsPath of the CreateJSONBody
+func (b *ScrapeConfigBuilder) MetricsPath(v string) *ScrapeConfigBuilder {
+	b.v.MetricsPath = &v
+	return b
+}
+
+// MetricsRelabelConfigs sets the MetricsRelabelConfigs of the CreateJSONBody
+func (b *ScrapeConfigBuilder) MetricsRelabelConfigs(v ...struct {
+	Action *CreateJSONBodyMetricsRelabelConfigsAction `json:"action,omitempty"`
+
+	Modulus *float32 `json:"modulus,omitempty"`
+
+	Regex *string `json:"regex,omitempty"`
+
+	Replacement *string `json:"replacement,omitempty"`
+
+	Separator *string `json:"separator,omitempty"`
+
+	SourceLabels *[]string `json:"sourceLabels,omitempty"`
+
+	TargetLabel *string `json:"targetLabel,omitempty"`
+}) *ScrapeConfigBuilder {
+	b.v.MetricsRelabelConfigs = &v
+	return b
+}
+
+// Oauth2 sets the Oauth2 of the CreateJSONBody
+func (b *ScrapeConfigBuilder) Oauth2(v struct {
+	ClientID string `json:"clientId"`
+
+	ClientSecret string `json:"clientSecret"`
+
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	TlsConfig *struct {
+		InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+	} `json:"tlsConfig,omitempty"`
+
+	TokenURL string `json:"tokenUrl"`
+}) *ScrapeConfigBuilder {
+	b.v.Oauth2 = &v
+	return b
+}
+
+// Params sets the Params of the CreateJSONBody
+func (b *ScrapeConfigBuilder) Params(v map[string]interface{}) *ScrapeConfigBuilder {
+	b.v.Params = &v
+	return b
+}
+
+// SampleLimit sets the SampleLimit of the CreateJSONBody
+func (b *ScrapeConfigBuilder) SampleLimit(v float32) *ScrapeConfigBuilder {
+	b.v.SampleLimit = &v
+	return b
+}
+
+// Scheme sets the Scheme of the CreateJSONBody
+func (b *ScrapeConfigBuilder) Scheme(v CreateJSONBodyScheme) *ScrapeConfigBuilder {
+	b.v.Scheme = v
+	return b
+}
+
+// ScrapeInterval sets the ScrapeInterval of the CreateJSONBody
+func (b *ScrapeConfigBuilder) ScrapeInterval(v string) *ScrapeConfigBuilder {
+	b.v.ScrapeInterval = v
+	return b
+}
+
+// ScrapeTimeout sets the ScrapeTimeout of the CreateJSONBody
+func (b *ScrapeConfigBuilder) ScrapeTimeout(v string) *ScrapeConfigBuilder {
+	b.v.ScrapeTimeout = v
+	return b
+}
+
+// StaticConfigs sets the StaticConfigs of the CreateJSONBody
+func (b *ScrapeConfigBuilder) StaticConfigs(v ...struct {
+	Labels *map[string]interface{} `json:"labels,omitempty"`
+
+	Targets []string `json:"targets"`
+}) *ScrapeConfigBuilder {
+	b.v.StaticConfigs = v
+	return b
+}
+
+// TlsConfig sets the TlsConfig of the CreateJSONBody
+func (b *ScrapeConfigBuilder) TlsConfig(v struct {
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+}) *ScrapeConfigBuilder {
+	b.v.TlsConfig = &v
+	return b
+}
+
+// Build returns the CreateJSONBody, and the errors of its Validate method
+func (b *ScrapeConfigBuilder) Build() (CreateJSONBody, error) {
+	return b.v, b.v.Validate()
+}
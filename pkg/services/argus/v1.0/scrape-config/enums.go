@@ -0,0 +1,281 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package scrapeconfig
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of CreateJSONBodyMetricsRelabelConfigsAction
+func (CreateJSONBodyMetricsRelabelConfigsAction) Values() []CreateJSONBodyMetricsRelabelConfigsAction {
+	return []CreateJSONBodyMetricsRelabelConfigsAction{
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP,
+		CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE,
+	}
+}
+
+// IsValid reports whether e is one of the values of CreateJSONBodyMetricsRelabelConfigsAction
+func (e CreateJSONBodyMetricsRelabelConfigsAction) IsValid() bool {
+	switch e {
+	case CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP, CREATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CreateJSONBodyMetricsRelabelConfigsAction) String() string {
+	return string(e)
+}
+
+// ParseCreateJSONBodyMetricsRelabelConfigsAction returns s as CreateJSONBodyMetricsRelabelConfigsAction, or a *validate.EnumError if s isn't one of its values
+func ParseCreateJSONBodyMetricsRelabelConfigsAction(s string) (CreateJSONBodyMetricsRelabelConfigsAction, error) {
+	return validate.ParseEnum[CreateJSONBodyMetricsRelabelConfigsAction](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CreateJSONBodyMetricsRelabelConfigsAction) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of CreateJSONBodyScheme
+func (CreateJSONBodyScheme) Values() []CreateJSONBodyScheme {
+	return []CreateJSONBodyScheme{
+		CREATE_JSON_BODY_SCHEME_HTTP,
+		CREATE_JSON_BODY_SCHEME_HTTPS,
+	}
+}
+
+// IsValid reports whether e is one of the values of CreateJSONBodyScheme
+func (e CreateJSONBodyScheme) IsValid() bool {
+	switch e {
+	case CREATE_JSON_BODY_SCHEME_HTTP, CREATE_JSON_BODY_SCHEME_HTTPS:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CreateJSONBodyScheme) String() string {
+	return string(e)
+}
+
+// ParseCreateJSONBodyScheme returns s as CreateJSONBodyScheme, or a *validate.EnumError if s isn't one of its values
+func ParseCreateJSONBodyScheme(s string) (CreateJSONBodyScheme, error) {
+	return validate.ParseEnum[CreateJSONBodyScheme](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CreateJSONBodyScheme) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of JobScheme
+func (JobScheme) Values() []JobScheme {
+	return []JobScheme{
+		JOB_SCHEME_HTTP,
+		JOB_SCHEME_HTTPS,
+	}
+}
+
+// IsValid reports whether e is one of the values of JobScheme
+func (e JobScheme) IsValid() bool {
+	switch e {
+	case JOB_SCHEME_HTTP, JOB_SCHEME_HTTPS:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e JobScheme) String() string {
+	return string(e)
+}
+
+// ParseJobScheme returns s as JobScheme, or a *validate.EnumError if s isn't one of its values
+func ParseJobScheme(s string) (JobScheme, error) {
+	return validate.ParseEnum[JobScheme](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *JobScheme) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of MetricsRelabelConfigAction
+func (MetricsRelabelConfigAction) Values() []MetricsRelabelConfigAction {
+	return []MetricsRelabelConfigAction{
+		METRICS_RELABEL_CONFIG_ACTION_DROP,
+		METRICS_RELABEL_CONFIG_ACTION_HASHMOD,
+		METRICS_RELABEL_CONFIG_ACTION_KEEP,
+		METRICS_RELABEL_CONFIG_ACTION_LABELDROP,
+		METRICS_RELABEL_CONFIG_ACTION_LABELKEEP,
+		METRICS_RELABEL_CONFIG_ACTION_LABELMAP,
+		METRICS_RELABEL_CONFIG_ACTION_REPLACE,
+	}
+}
+
+// IsValid reports whether e is one of the values of MetricsRelabelConfigAction
+func (e MetricsRelabelConfigAction) IsValid() bool {
+	switch e {
+	case METRICS_RELABEL_CONFIG_ACTION_DROP, METRICS_RELABEL_CONFIG_ACTION_HASHMOD, METRICS_RELABEL_CONFIG_ACTION_KEEP, METRICS_RELABEL_CONFIG_ACTION_LABELDROP, METRICS_RELABEL_CONFIG_ACTION_LABELKEEP, METRICS_RELABEL_CONFIG_ACTION_LABELMAP, METRICS_RELABEL_CONFIG_ACTION_REPLACE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e MetricsRelabelConfigAction) String() string {
+	return string(e)
+}
+
+// ParseMetricsRelabelConfigAction returns s as MetricsRelabelConfigAction, or a *validate.EnumError if s isn't one of its values
+func ParseMetricsRelabelConfigAction(s string) (MetricsRelabelConfigAction, error) {
+	return validate.ParseEnum[MetricsRelabelConfigAction](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *MetricsRelabelConfigAction) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of PartialUpdateJSONBodyMetricsRelabelConfigsAction
+func (PartialUpdateJSONBodyMetricsRelabelConfigsAction) Values() []PartialUpdateJSONBodyMetricsRelabelConfigsAction {
+	return []PartialUpdateJSONBodyMetricsRelabelConfigsAction{
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP,
+		PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PartialUpdateJSONBodyMetricsRelabelConfigsAction
+func (e PartialUpdateJSONBodyMetricsRelabelConfigsAction) IsValid() bool {
+	switch e {
+	case PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP, PARTIAL_UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e PartialUpdateJSONBodyMetricsRelabelConfigsAction) String() string {
+	return string(e)
+}
+
+// ParsePartialUpdateJSONBodyMetricsRelabelConfigsAction returns s as PartialUpdateJSONBodyMetricsRelabelConfigsAction, or a *validate.EnumError if s isn't one of its values
+func ParsePartialUpdateJSONBodyMetricsRelabelConfigsAction(s string) (PartialUpdateJSONBodyMetricsRelabelConfigsAction, error) {
+	return validate.ParseEnum[PartialUpdateJSONBodyMetricsRelabelConfigsAction](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *PartialUpdateJSONBodyMetricsRelabelConfigsAction) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of PartialUpdateJSONBodyScheme
+func (PartialUpdateJSONBodyScheme) Values() []PartialUpdateJSONBodyScheme {
+	return []PartialUpdateJSONBodyScheme{
+		PARTIAL_UPDATE_JSON_BODY_SCHEME_HTTP,
+		PARTIAL_UPDATE_JSON_BODY_SCHEME_HTTPS,
+	}
+}
+
+// IsValid reports whether e is one of the values of PartialUpdateJSONBodyScheme
+func (e PartialUpdateJSONBodyScheme) IsValid() bool {
+	switch e {
+	case PARTIAL_UPDATE_JSON_BODY_SCHEME_HTTP, PARTIAL_UPDATE_JSON_BODY_SCHEME_HTTPS:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e PartialUpdateJSONBodyScheme) String() string {
+	return string(e)
+}
+
+// ParsePartialUpdateJSONBodyScheme returns s as PartialUpdateJSONBodyScheme, or a *validate.EnumError if s isn't one of its values
+func ParsePartialUpdateJSONBodyScheme(s string) (PartialUpdateJSONBodyScheme, error) {
+	return validate.ParseEnum[PartialUpdateJSONBodyScheme](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *PartialUpdateJSONBodyScheme) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of UpdateJSONBodyMetricsRelabelConfigsAction
+func (UpdateJSONBodyMetricsRelabelConfigsAction) Values() []UpdateJSONBodyMetricsRelabelConfigsAction {
+	return []UpdateJSONBodyMetricsRelabelConfigsAction{
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP,
+		UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE,
+	}
+}
+
+// IsValid reports whether e is one of the values of UpdateJSONBodyMetricsRelabelConfigsAction
+func (e UpdateJSONBodyMetricsRelabelConfigsAction) IsValid() bool {
+	switch e {
+	case UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_DROP, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_HASHMOD, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_KEEP, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELDROP, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELKEEP, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_LABELMAP, UPDATE_JSON_BODY_METRICS_RELABEL_CONFIGS_ACTION_REPLACE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e UpdateJSONBodyMetricsRelabelConfigsAction) String() string {
+	return string(e)
+}
+
+// ParseUpdateJSONBodyMetricsRelabelConfigsAction returns s as UpdateJSONBodyMetricsRelabelConfigsAction, or a *validate.EnumError if s isn't one of its values
+func ParseUpdateJSONBodyMetricsRelabelConfigsAction(s string) (UpdateJSONBodyMetricsRelabelConfigsAction, error) {
+	return validate.ParseEnum[UpdateJSONBodyMetricsRelabelConfigsAction](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *UpdateJSONBodyMetricsRelabelConfigsAction) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of UpdateJSONBodyScheme
+func (UpdateJSONBodyScheme) Values() []UpdateJSONBodyScheme {
+	return []UpdateJSONBodyScheme{
+		UPDATE_JSON_BODY_SCHEME_HTTP,
+		UPDATE_JSON_BODY_SCHEME_HTTPS,
+	}
+}
+
+// IsValid reports whether e is one of the values of UpdateJSONBodyScheme
+func (e UpdateJSONBodyScheme) IsValid() bool {
+	switch e {
+	case UPDATE_JSON_BODY_SCHEME_HTTP, UPDATE_JSON_BODY_SCHEME_HTTPS:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e UpdateJSONBodyScheme) String() string {
+	return string(e)
+}
+
+// ParseUpdateJSONBodyScheme returns s as UpdateJSONBodyScheme, or a *validate.EnumError if s isn't one of its values
+func ParseUpdateJSONBodyScheme(s string) (UpdateJSONBodyScheme, error) {
+	return validate.ParseEnum[UpdateJSONBodyScheme](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *UpdateJSONBodyScheme) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -3,6 +3,23 @@ package argus
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/acl"
+	alertconfig "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/alert-config"
+	alertgroups "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/alert-groups"
+	alertrecords "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/alert-records"
+	alertrules "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/alert-rules"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/backup"
+	certcheck "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/cert-check"
+	grafanaconfigs "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/grafana-configs"
+	httpcheck "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/http-check"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/logs"
+	metricsstorageretention "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/metrics-storage-retention"
+	networkcheck "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/network-check"
+	pingcheck "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/ping-check"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/plans"
+	scrapeconfig "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/scrape-config"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/traces"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,7 +27,50 @@ var BaseURLs = baseurl.New(
 	"https://argus.api.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(c))
-	return nc
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Instances               instances.ClientWithResponsesInterface
+	Acl                     acl.ClientWithResponsesInterface
+	AlertConfig             alertconfig.ClientWithResponsesInterface
+	AlertGroups             alertgroups.ClientWithResponsesInterface
+	AlertRules              alertrules.ClientWithResponsesInterface
+	AlertRecords            alertrecords.ClientWithResponsesInterface
+	Backup                  backup.ClientWithResponsesInterface
+	CertCheck               certcheck.ClientWithResponsesInterface
+	GrafanaConfigs          grafanaconfigs.ClientWithResponsesInterface
+	HttpCheck               httpcheck.ClientWithResponsesInterface
+	Logs                    logs.ClientWithResponsesInterface
+	MetricsStorageRetention metricsstorageretention.ClientWithResponsesInterface
+	NetworkCheck            networkcheck.ClientWithResponsesInterface
+	PingCheck               pingcheck.ClientWithResponsesInterface
+	ScrapeConfig            scrapeconfig.ClientWithResponsesInterface
+	Traces                  traces.ClientWithResponsesInterface
+	Plans                   plans.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
+	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(contracts.WithUserAgent(c, "argus", "v1.0")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Instances:               nc.Instances,
+		Acl:                     nc.Acl,
+		AlertConfig:             nc.AlertConfig,
+		AlertGroups:             nc.AlertGroups,
+		AlertRules:              nc.AlertRules,
+		AlertRecords:            nc.AlertRecords,
+		Backup:                  nc.Backup,
+		CertCheck:               nc.CertCheck,
+		GrafanaConfigs:          nc.GrafanaConfigs,
+		HttpCheck:               nc.HttpCheck,
+		Logs:                    nc.Logs,
+		MetricsStorageRetention: nc.MetricsStorageRetention,
+		NetworkCheck:            nc.NetworkCheck,
+		PingCheck:               nc.PingCheck,
+		ScrapeConfig:            nc.ScrapeConfig,
+		Traces:                  nc.Traces,
+		Plans:                   nc.Plans,
+	}
 }
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/pkg/errors"
+
+	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
+	costs "github.com/SchwarzIT/community-stackit-go-client/pkg/services/costs/v2.0"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1"
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0"
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	mongodbflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0"
+	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+	postgresflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	scf "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0"
+	secretsmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
+)
+
+// CheckStatus is the outcome of a single check
+type CheckStatus string
+
+const (
+	CHECK_OK      CheckStatus = "ok"
+	CHECK_FAILED  CheckStatus = "failed"
+	CHECK_SKIPPED CheckStatus = "skipped"
+)
+
+// CheckResult holds the outcome of a single check
+type CheckResult struct {
+	Name     string        `json:"name"`
+	Status   CheckStatus   `json:"status"`
+	BaseURL  string        `json:"baseURL,omitempty"`
+	Message  string        `json:"message,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// HostResolver resolves host names, it's implemented by *net.Resolver
+type HostResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// CheckReport is the structured result of Services.Check
+type CheckReport struct {
+	Healthy     bool          `json:"healthy"`
+	Credentials CheckResult   `json:"credentials"`
+	Token       CheckResult   `json:"token"`
+	JWKS        CheckResult   `json:"jwks"`
+	Services    []CheckResult `json:"services"`
+}
+
+// Err returns an error describing all failed checks
+// or nil if the report is healthy
+func (r CheckReport) Err() error {
+	failed := []string{}
+	for _, c := range append([]CheckResult{r.Credentials, r.Token, r.JWKS}, r.Services...) {
+		if c.Status == CHECK_FAILED {
+			failed = append(failed, fmt.Sprintf("%s: %s", c.Name, c.Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("client check failed:\n%s", strings.Join(failed, "\n"))
+}
+
+// Check verifies the configured client is usable:
+// credentials are loaded, a token can be obtained (key & impersonation flows) or an authenticated call succeeds (token flow),
+// the JWKS endpoint is reachable (key flow) and the base URL of every enabled service resolves
+// the returned report is suitable for readiness probes
+func (s *Services) Check(ctx context.Context) CheckReport {
+	r := CheckReport{}
+	switch c := s.Client.(type) {
+	case *clients.KeyFlow:
+		r.Credentials = runCheck("credentials", func() error { return checkKeyFlowCredentials(c) })
+		r.Token = runCheck("token", func() error {
+			_, err := c.GetAccessToken()
+			return err
+		})
+		r.JWKS = runCheck("jwks", func() error { return c.CheckJWKS(ctx) })
+	case *clients.TokenFlow:
+		r.Credentials = runCheck("credentials", func() error { return checkTokenFlowCredentials(c) })
+		r.Token = runCheck("token", func() error { return s.checkAuthenticatedCall(ctx) })
+		r.JWKS = skipCheck("jwks", "not used by token flow")
+	case *ImpersonationFlow:
+		r.Credentials = runCheck("credentials", func() error { return checkImpersonationFlowCredentials(c) })
+		r.Token = runCheck("token", func() error {
+			_, err := c.GetAccessToken(ctx)
+			return err
+		})
+		r.JWKS = skipCheck("jwks", "not used by impersonation flow")
+	default:
+		r.Credentials = skipCheck("credentials", "unknown client flow")
+		r.Token = skipCheck("token", "unknown client flow")
+		r.JWKS = skipCheck("jwks", "unknown client flow")
+	}
+
+	for _, svc := range s.enabledServices() {
+		u := svc.url.Get()
+		res := runCheck(svc.name, func() error { return resolveBaseURL(ctx, s.resolver(), u) })
+		res.BaseURL = u
+		r.Services = append(r.Services, res)
+	}
+
+	r.Healthy = r.Err() == nil
+	return r
+}
+
+// checkKeyFlowCredentials verifies the service account key and private key were loaded
+func checkKeyFlowCredentials(c *clients.KeyFlow) error {
+	cfg := c.GetConfig()
+	if len(cfg.ServiceAccountKey) == 0 {
+		return errors.New("service account key is not loaded")
+	}
+	if len(cfg.PrivateKey) == 0 {
+		return errors.New("private key is not loaded")
+	}
+	if c.GetServiceAccountEmail() == "" {
+		return errors.New("service account key has no issuer")
+	}
+	return nil
+}
+
+// checkTokenFlowCredentials verifies the service account email and token are set
+func checkTokenFlowCredentials(c *clients.TokenFlow) error {
+	cfg := c.GetConfig()
+	if cfg.ServiceAccountToken == "" {
+		return errors.New("service account token is empty")
+	}
+	if cfg.ServiceAccountEmail == "" {
+		return errors.New("service account email is empty")
+	}
+	return nil
+}
+
+// checkImpersonationFlowCredentials verifies the target service account is set
+func checkImpersonationFlowCredentials(c *ImpersonationFlow) error {
+	cfg := c.GetConfig()
+	if cfg.ProjectID == "" {
+		return errors.New("target project ID is empty")
+	}
+	if cfg.ServiceAccountEmail == "" {
+		return errors.New("target service account email is empty")
+	}
+	return nil
+}
+
+// checkAuthenticatedCall performs a cheap authenticated call
+// listing the service account's own memberships
+// a rejected token (401 or 403) or a failed request is considered a failure
+// other error responses still prove the token was accepted
+func (s *Services) checkAuthenticatedCall(ctx context.Context) error {
+	if s.Membership == nil {
+		return errors.New("membership service is not enabled")
+	}
+	res, err := s.Membership.GetUserMemberships(ctx, s.Client.GetServiceAccountEmail(), &membership.GetUserMembershipsParams{})
+	if err != nil {
+		return err
+	}
+	if err := validate.Response(res, nil); err != nil && validate.StatusEquals(res, http.StatusUnauthorized, http.StatusForbidden) {
+		return err
+	}
+	return nil
+}
+
+// resolver returns the resolver used to check the base URLs of the services
+func (s *Services) resolver() HostResolver {
+	if s.Resolver == nil {
+		return net.DefaultResolver
+	}
+	return s.Resolver
+}
+
+// resolveBaseURL verifies the base URL is valid and its host can be resolved by r
+func resolveBaseURL(ctx context.Context, r HostResolver, baseURL string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Hostname() == "" {
+		return fmt.Errorf("invalid base URL '%s'", baseURL)
+	}
+	if _, err := r.LookupHost(ctx, u.Hostname()); err != nil {
+		return errors.Wrap(err, "failed to resolve host")
+	}
+	return nil
+}
+
+type enabledService struct {
+	name string
+	url  baseurl.BaseURL
+}
+
+// enabledServices returns the name and base URL of every initialized service
+func (s *Services) enabledServices() []enabledService {
+	all := []struct {
+		enabled bool
+		enabledService
+	}{
+		{s.Argus != nil, enabledService{"argus", argus.BaseURLs}},
+		{s.Costs != nil, enabledService{"costs", costs.BaseURLs}},
+		{s.IAAS != nil, enabledService{"iaas", iaas.BaseURLs}},
+		{s.Kubernetes != nil, enabledService{"kubernetes", kubernetes.BaseURLs}},
+		{s.LoadBalancer != nil, enabledService{"load-balancer", loadbalancer.BaseURLs}},
+		{s.Membership != nil, enabledService{"membership", membership.BaseURLs}},
+		{s.MongoDBFlex != nil, enabledService{"mongodb-flex", mongodbflex.BaseURLs}},
+		{s.ObjectStorage != nil, enabledService{"object-storage", objectstorage.BaseURLs}},
+		{s.PostgresFlex != nil, enabledService{"postgres-flex", postgresflex.BaseURLs}},
+		{s.ResourceManagement != nil, enabledService{"resource-management", resourcemanagement.BaseURLs}},
+		{s.SecretsManager != nil, enabledService{"secrets-manager", secretsmanager.BaseURLs}},
+		{s.ServiceAccounts != nil, enabledService{"service-accounts", serviceaccounts.BaseURLs}},
+		{s.ServiceEnablement != nil, enabledService{"service-enablement", serviceenablement.BaseURLs}},
+		{s.SCF != nil, enabledService{"scf", scf.BaseURLs}},
+
+		// DSA
+		{s.ElasticSearch != nil, enabledService{"elasticsearch", dataservices.GetBaseURLs(dataservices.ElasticSearch)}},
+		{s.LogMe != nil, enabledService{"logme", dataservices.GetBaseURLs(dataservices.LogMe)}},
+		{s.MariaDB != nil, enabledService{"mariadb", dataservices.GetBaseURLs(dataservices.MariaDB)}},
+		{s.MongoDB != nil, enabledService{"mongodb", dataservices.GetBaseURLs(dataservices.MongoDB)}},
+		{s.Opensearch != nil, enabledService{"opensearch", dataservices.GetBaseURLs(dataservices.Opensearch)}},
+		{s.PostgresDB != nil, enabledService{"postgresql", dataservices.GetBaseURLs(dataservices.PostgresDB)}},
+		{s.RabbitMQ != nil, enabledService{"rabbitmq", dataservices.GetBaseURLs(dataservices.RabbitMQ)}},
+		{s.Redis != nil, enabledService{"redis", dataservices.GetBaseURLs(dataservices.Redis)}},
+	}
+	res := []enabledService{}
+	for _, v := range all {
+		if v.enabled {
+			res = append(res, v.enabledService)
+		}
+	}
+	return res
+}
+
+// runCheck runs a given check function and records its outcome
+func runCheck(name string, fn func() error) CheckResult {
+	start := time.Now()
+	err := fn()
+	res := CheckResult{
+		Name:     name,
+		Status:   CHECK_OK,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		res.Status = CHECK_FAILED
+		res.Message = err.Error()
+	}
+	return res
+}
+
+// skipCheck records a skipped check
+func skipCheck(name, reason string) CheckResult {
+	return CheckResult{
+		Name:    name,
+		Status:  CHECK_SKIPPED,
+		Message: reason,
+	}
+}
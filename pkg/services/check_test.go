@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/stackittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResolver resolves every host except the failing ones
+type fakeResolver struct {
+	failing map[string]bool
+}
+
+func (r fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if r.failing[host] {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return []string{"127.0.0.1"}, nil
+}
+
+func TestServices_Check_KeyFlow(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	c, err := srv.Client(ctx)
+	require.NoError(t, err)
+
+	r := c.Check(ctx)
+	assert.Equal(t, services.CHECK_OK, r.Credentials.Status, r.Credentials.Message)
+	assert.Equal(t, services.CHECK_OK, r.Token.Status, r.Token.Message)
+	assert.Equal(t, services.CHECK_OK, r.JWKS.Status, r.JWKS.Message)
+}
+
+func TestServices_Check_TokenFlow(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	tf := &clients.TokenFlow{}
+	require.NoError(t, tf.Init(ctx, srv.TokenFlowConfig()))
+	c, err := services.Init(tf)
+	require.NoError(t, err)
+
+	r := c.Check(ctx)
+	assert.Equal(t, services.CHECK_OK, r.Credentials.Status, r.Credentials.Message)
+	assert.Equal(t, services.CHECK_OK, r.Token.Status, r.Token.Message)
+	assert.Equal(t, services.CHECK_SKIPPED, r.JWKS.Status)
+}
+
+func TestServices_Check_InvalidToken(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	cfg := srv.TokenFlowConfig()
+	cfg.ServiceAccountToken = "invalid"
+	tf := &clients.TokenFlow{}
+	require.NoError(t, tf.Init(ctx, cfg))
+	c, err := services.Init(tf)
+	require.NoError(t, err)
+
+	r := c.Check(ctx)
+	assert.Equal(t, services.CHECK_OK, r.Credentials.Status, r.Credentials.Message)
+	assert.Equal(t, services.CHECK_FAILED, r.Token.Status)
+	assert.False(t, r.Healthy)
+	assert.ErrorContains(t, r.Err(), "token: ")
+}
+
+func TestServices_Check_Services(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	c, err := srv.Client(ctx)
+	require.NoError(t, err)
+
+	c.Resolver = fakeResolver{}
+	r := c.Check(ctx)
+	require.NotEmpty(t, r.Services)
+	for _, res := range r.Services {
+		assert.Equal(t, services.CHECK_OK, res.Status, res.Name)
+		assert.NotEmpty(t, res.BaseURL, res.Name)
+	}
+	assert.True(t, r.Healthy)
+	assert.NoError(t, r.Err())
+
+	c.Resolver = fakeResolver{failing: map[string]bool{"argus.api.stackit.cloud": true}}
+	r = c.Check(ctx)
+	for _, res := range r.Services {
+		if res.Name != "argus" {
+			assert.Equal(t, services.CHECK_OK, res.Status, res.Name)
+			continue
+		}
+		assert.Equal(t, services.CHECK_FAILED, res.Status)
+		assert.Equal(t, argus.BaseURLs.Get(), res.BaseURL)
+		assert.Contains(t, res.Message, "failed to resolve host")
+	}
+	assert.False(t, r.Healthy)
+	assert.ErrorContains(t, r.Err(), "argus: failed to resolve host")
+
+	// disabled services aren't checked
+	c.Argus = nil
+	r = c.Check(ctx)
+	for _, res := range r.Services {
+		assert.NotEqual(t, "argus", res.Name)
+	}
+	assert.True(t, r.Healthy)
+}
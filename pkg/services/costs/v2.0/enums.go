@@ -0,0 +1,371 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package costs
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept
+func (GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept) Values() []GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept {
+	return []GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_ACCEPT_APPLICATION_JSON,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_ACCEPT_TEXT_CSV,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_ACCEPT_APPLICATION_JSON, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_ACCEPT_TEXT_CSV:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept returns s as GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept(s string) (GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInAllSubCustomerAccountsParamsAccept) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth
+func (GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth) Values() []GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth {
+	return []GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_AUTO,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_PROJECT,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_SERVICE,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_AUTO, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_PROJECT, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_DEPTH_SERVICE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth returns s as GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth(s string) (GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInAllSubCustomerAccountsParamsDepth) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity
+func (GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity) Values() []GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity {
+	return []GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_DAILY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_MONTHLY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_NONE,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_WEEKLY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_YEARLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_DAILY, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_MONTHLY, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_NONE, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_WEEKLY, GET_COSTS_FOR_ALL_PROJECTS_IN_ALL_SUB_CUSTOMER_ACCOUNTS_PARAMS_GRANULARITY_YEARLY:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity returns s as GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity(s string) (GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInAllSubCustomerAccountsParamsGranularity) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetCostsForAllProjectsInCustomerAccountParamsAccept
+func (GetCostsForAllProjectsInCustomerAccountParamsAccept) Values() []GetCostsForAllProjectsInCustomerAccountParamsAccept {
+	return []GetCostsForAllProjectsInCustomerAccountParamsAccept{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_APPLICATION_JSON,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_TEXT_CSV,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInCustomerAccountParamsAccept
+func (e GetCostsForAllProjectsInCustomerAccountParamsAccept) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_APPLICATION_JSON, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_TEXT_CSV:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInCustomerAccountParamsAccept) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInCustomerAccountParamsAccept returns s as GetCostsForAllProjectsInCustomerAccountParamsAccept, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInCustomerAccountParamsAccept(s string) (GetCostsForAllProjectsInCustomerAccountParamsAccept, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInCustomerAccountParamsAccept](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInCustomerAccountParamsAccept) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetCostsForAllProjectsInCustomerAccountParamsDepth
+func (GetCostsForAllProjectsInCustomerAccountParamsDepth) Values() []GetCostsForAllProjectsInCustomerAccountParamsDepth {
+	return []GetCostsForAllProjectsInCustomerAccountParamsDepth{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_AUTO,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_PROJECT,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_SERVICE,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInCustomerAccountParamsDepth
+func (e GetCostsForAllProjectsInCustomerAccountParamsDepth) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_AUTO, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_PROJECT, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_DEPTH_SERVICE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInCustomerAccountParamsDepth) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInCustomerAccountParamsDepth returns s as GetCostsForAllProjectsInCustomerAccountParamsDepth, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInCustomerAccountParamsDepth(s string) (GetCostsForAllProjectsInCustomerAccountParamsDepth, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInCustomerAccountParamsDepth](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInCustomerAccountParamsDepth) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetCostsForAllProjectsInCustomerAccountParamsGranularity
+func (GetCostsForAllProjectsInCustomerAccountParamsGranularity) Values() []GetCostsForAllProjectsInCustomerAccountParamsGranularity {
+	return []GetCostsForAllProjectsInCustomerAccountParamsGranularity{
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_DAILY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_MONTHLY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_NONE,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_WEEKLY,
+		GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_YEARLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetCostsForAllProjectsInCustomerAccountParamsGranularity
+func (e GetCostsForAllProjectsInCustomerAccountParamsGranularity) IsValid() bool {
+	switch e {
+	case GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_DAILY, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_MONTHLY, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_NONE, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_WEEKLY, GET_COSTS_FOR_ALL_PROJECTS_IN_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_YEARLY:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetCostsForAllProjectsInCustomerAccountParamsGranularity) String() string {
+	return string(e)
+}
+
+// ParseGetCostsForAllProjectsInCustomerAccountParamsGranularity returns s as GetCostsForAllProjectsInCustomerAccountParamsGranularity, or a *validate.EnumError if s isn't one of its values
+func ParseGetCostsForAllProjectsInCustomerAccountParamsGranularity(s string) (GetCostsForAllProjectsInCustomerAccountParamsGranularity, error) {
+	return validate.ParseEnum[GetCostsForAllProjectsInCustomerAccountParamsGranularity](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetCostsForAllProjectsInCustomerAccountParamsGranularity) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetForecastCustomerAccountParamsAccept
+func (GetForecastCustomerAccountParamsAccept) Values() []GetForecastCustomerAccountParamsAccept {
+	return []GetForecastCustomerAccountParamsAccept{
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_APPLICATION_JSON,
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_TEXT_CSV,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetForecastCustomerAccountParamsAccept
+func (e GetForecastCustomerAccountParamsAccept) IsValid() bool {
+	switch e {
+	case GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_APPLICATION_JSON, GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_ACCEPT_TEXT_CSV:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetForecastCustomerAccountParamsAccept) String() string {
+	return string(e)
+}
+
+// ParseGetForecastCustomerAccountParamsAccept returns s as GetForecastCustomerAccountParamsAccept, or a *validate.EnumError if s isn't one of its values
+func ParseGetForecastCustomerAccountParamsAccept(s string) (GetForecastCustomerAccountParamsAccept, error) {
+	return validate.ParseEnum[GetForecastCustomerAccountParamsAccept](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetForecastCustomerAccountParamsAccept) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetForecastCustomerAccountParamsGranularity
+func (GetForecastCustomerAccountParamsGranularity) Values() []GetForecastCustomerAccountParamsGranularity {
+	return []GetForecastCustomerAccountParamsGranularity{
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_DAILY,
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_MONTHLY,
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_NONE,
+		GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_WEEKLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetForecastCustomerAccountParamsGranularity
+func (e GetForecastCustomerAccountParamsGranularity) IsValid() bool {
+	switch e {
+	case GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_DAILY, GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_MONTHLY, GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_NONE, GET_FORECAST_CUSTOMER_ACCOUNT_PARAMS_GRANULARITY_WEEKLY:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetForecastCustomerAccountParamsGranularity) String() string {
+	return string(e)
+}
+
+// ParseGetForecastCustomerAccountParamsGranularity returns s as GetForecastCustomerAccountParamsGranularity, or a *validate.EnumError if s isn't one of its values
+func ParseGetForecastCustomerAccountParamsGranularity(s string) (GetForecastCustomerAccountParamsGranularity, error) {
+	return validate.ParseEnum[GetForecastCustomerAccountParamsGranularity](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetForecastCustomerAccountParamsGranularity) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetProjectCostsParamsAccept
+func (GetProjectCostsParamsAccept) Values() []GetProjectCostsParamsAccept {
+	return []GetProjectCostsParamsAccept{
+		GET_PROJECT_COSTS_PARAMS_ACCEPT_APPLICATION_JSON,
+		GET_PROJECT_COSTS_PARAMS_ACCEPT_TEXT_CSV,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetProjectCostsParamsAccept
+func (e GetProjectCostsParamsAccept) IsValid() bool {
+	switch e {
+	case GET_PROJECT_COSTS_PARAMS_ACCEPT_APPLICATION_JSON, GET_PROJECT_COSTS_PARAMS_ACCEPT_TEXT_CSV:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetProjectCostsParamsAccept) String() string {
+	return string(e)
+}
+
+// ParseGetProjectCostsParamsAccept returns s as GetProjectCostsParamsAccept, or a *validate.EnumError if s isn't one of its values
+func ParseGetProjectCostsParamsAccept(s string) (GetProjectCostsParamsAccept, error) {
+	return validate.ParseEnum[GetProjectCostsParamsAccept](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetProjectCostsParamsAccept) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetProjectCostsParamsDepth
+func (GetProjectCostsParamsDepth) Values() []GetProjectCostsParamsDepth {
+	return []GetProjectCostsParamsDepth{
+		AUTO,
+		PROJECT,
+		SERVICE,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetProjectCostsParamsDepth
+func (e GetProjectCostsParamsDepth) IsValid() bool {
+	switch e {
+	case AUTO, PROJECT, SERVICE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetProjectCostsParamsDepth) String() string {
+	return string(e)
+}
+
+// ParseGetProjectCostsParamsDepth returns s as GetProjectCostsParamsDepth, or a *validate.EnumError if s isn't one of its values
+func ParseGetProjectCostsParamsDepth(s string) (GetProjectCostsParamsDepth, error) {
+	return validate.ParseEnum[GetProjectCostsParamsDepth](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetProjectCostsParamsDepth) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetProjectCostsParamsGranularity
+func (GetProjectCostsParamsGranularity) Values() []GetProjectCostsParamsGranularity {
+	return []GetProjectCostsParamsGranularity{
+		GET_PROJECT_COSTS_PARAMS_GRANULARITY_DAILY,
+		GET_PROJECT_COSTS_PARAMS_GRANULARITY_MONTHLY,
+		GET_PROJECT_COSTS_PARAMS_GRANULARITY_NONE,
+		GET_PROJECT_COSTS_PARAMS_GRANULARITY_WEEKLY,
+		GET_PROJECT_COSTS_PARAMS_GRANULARITY_YEARLY,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetProjectCostsParamsGranularity
+func (e GetProjectCostsParamsGranularity) IsValid() bool {
+	switch e {
+	case GET_PROJECT_COSTS_PARAMS_GRANULARITY_DAILY, GET_PROJECT_COSTS_PARAMS_GRANULARITY_MONTHLY, GET_PROJECT_COSTS_PARAMS_GRANULARITY_NONE, GET_PROJECT_COSTS_PARAMS_GRANULARITY_WEEKLY, GET_PROJECT_COSTS_PARAMS_GRANULARITY_YEARLY:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetProjectCostsParamsGranularity) String() string {
+	return string(e)
+}
+
+// ParseGetProjectCostsParamsGranularity returns s as GetProjectCostsParamsGranularity, or a *validate.EnumError if s isn't one of its values
+func ParseGetProjectCostsParamsGranularity(s string) (GetProjectCostsParamsGranularity, error) {
+	return validate.ParseEnum[GetProjectCostsParamsGranularity](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetProjectCostsParamsGranularity) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
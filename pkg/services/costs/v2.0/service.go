@@ -11,6 +11,6 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	s, _ := NewClient(BaseURLs.Get(), WithHTTPClient(c))
+	s, _ := NewClient(BaseURLs.Get(), WithHTTPClient(contracts.WithUserAgent(c, "costs", "v2.0")))
 	return s
 }
@@ -0,0 +1,71 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package instances
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of LastOperationState
+func (LastOperationState) Values() []LastOperationState {
+	return []LastOperationState{
+		FAILED,
+		IN_PROGRESS,
+		SUCCEEDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of LastOperationState
+func (e LastOperationState) IsValid() bool {
+	switch e {
+	case FAILED, IN_PROGRESS, SUCCEEDED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e LastOperationState) String() string {
+	return string(e)
+}
+
+// ParseLastOperationState returns s as LastOperationState, or a *validate.EnumError if s isn't one of its values
+func ParseLastOperationState(s string) (LastOperationState, error) {
+	return validate.ParseEnum[LastOperationState](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *LastOperationState) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of LastOperationType
+func (LastOperationType) Values() []LastOperationType {
+	return []LastOperationType{
+		CREATE,
+		DELETE,
+		UPDATE,
+	}
+}
+
+// IsValid reports whether e is one of the values of LastOperationType
+func (e LastOperationType) IsValid() bool {
+	switch e {
+	case CREATE, DELETE, UPDATE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e LastOperationType) String() string {
+	return string(e)
+}
+
+// ParseLastOperationType returns s as LastOperationType, or a *validate.EnumError if s isn't one of its values
+func ParseLastOperationType(s string) (LastOperationType, error) {
+	return validate.ParseEnum[LastOperationType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *LastOperationType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
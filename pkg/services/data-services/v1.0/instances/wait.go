@@ -2,94 +2,113 @@ package instances
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
 const (
-	client_timeout_err = "Client.Timeout exceeded while awaiting headers"
+	kindProvision   = "instances_provision"
+	kindUpdate      = "instances_update"
+	kindDeprovision = "instances_deprovision"
 )
 
-func (ProvisionResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for instance provisioning
+// returned value is the last observed *GetResponse
+func (ProvisionResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*GetResponse] {
+	return wait.NewHandler(func() (res *GetResponse, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(s, err, "JSON200"); err != nil {
-			if strings.Contains(err.Error(), client_timeout_err) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
-			return nil, false, err
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if s.JSON200.LastOperation.State == SUCCEEDED {
 			return s, true, nil
 		}
 		if s.JSON200.LastOperation.State == FAILED {
-			return nil, false, errors.New("received failed status from DSA instance")
+			return s, false, operationFailure(s.JSON200.LastOperation)
 		}
-		return nil, false, nil
-	})
+		return s, false, nil
+	}).SetStateFunc(instanceState).
+		Describe("data-services", kindProvision, "succeeded", map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
-func (UpdateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for instance update
+// returned value is the last observed *GetResponse
+func (UpdateResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*GetResponse] {
+	return wait.NewHandler(func() (res *GetResponse, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(s, err, "JSON200"); err != nil {
-			if strings.Contains(err.Error(), client_timeout_err) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
-			return nil, false, err
-		}
-		if s.JSON200.LastOperation.Type == UPDATE {
-			return nil, false, nil
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if s.JSON200.LastOperation.State == SUCCEEDED {
 			return s, true, nil
 		}
 		if s.JSON200.LastOperation.State == FAILED {
-			return nil, false, errors.New("received failed status from DSA instance")
+			return s, false, operationFailure(s.JSON200.LastOperation)
 		}
-
-		return nil, false, fmt.Errorf("received unexpected status from DSA instance: %s", s.JSON200.LastOperation.State)
-	})
+		if s.JSON200.LastOperation.Type == UPDATE && s.JSON200.LastOperation.State == IN_PROGRESS {
+			return s, false, nil
+		}
+		return s, false, fmt.Errorf("received unexpected status from DSA instance: %s", s.JSON200.LastOperation.State)
+	}).SetStateFunc(instanceState).
+		Describe("data-services", kindUpdate, "succeeded", map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
-func (DeprovisionResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// WaitHandler will wait for instance deprovisioning
+// returned value is the last observed *GetResponse or nil if the instance is gone
+func (DeprovisionResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*GetResponse] {
+	return wait.NewHandler(func() (res *GetResponse, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(s, err, "JSON200"); err != nil {
-			if strings.Contains(err.Error(), client_timeout_err) ||
-				validate.StatusEquals(s,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					http.StatusInternalServerError,
-				) {
-				return nil, false, nil
-			}
 			if validate.StatusEquals(s, http.StatusNotFound, http.StatusGone, http.StatusForbidden) {
 				return nil, true, nil
 			}
-			return nil, false, err
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if s.JSON200.LastOperation.Type != DELETE {
-			return nil, false, nil
+			return s, false, nil
 		}
 		if s.JSON200.LastOperation.State == SUCCEEDED {
 			return s, true, nil
 		}
-		return nil, false, nil
-	})
+		if s.JSON200.LastOperation.State == FAILED {
+			return s, false, operationFailure(s.JSON200.LastOperation)
+		}
+		return s, false, nil
+	}).SetStateFunc(instanceState).
+		Describe("data-services", kindDeprovision, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// operationFailure returns a *wait.TerminalError for a failed last operation
+func operationFailure(op LastOperation) error {
+	te := &wait.TerminalError{State: fmt.Sprintf("%s %s", op.Type, op.State)}
+	if op.Description != "" {
+		te.Details = []string{op.Description}
+	}
+	return te
+}
+
+// instanceState returns the state of the last operation of an observed instance
+func instanceState(res *GetResponse) string {
+	if res == nil || res.JSON200 == nil {
+		return ""
+	}
+	return string(res.JSON200.LastOperation.State)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindProvision:
+		return wait.Any(ProvisionResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindUpdate:
+		return wait.Any(UpdateResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindDeprovision:
+		return wait.Any(DeprovisionResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
@@ -0,0 +1,26 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the instances of a project
+// instances are keyed by ID
+func NewWatcher(c ClientWithResponsesInterface, projectID string) *watch.Watcher[Instance] {
+	return watch.New(func(ctx context.Context) (map[string]Instance, error) {
+		resp, err := c.List(ctx, projectID)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]Instance{}
+		for _, i := range resp.JSON200.Instances {
+			if i.InstanceID != nil {
+				items[*i.InstanceID] = i
+			}
+		}
+		return items, nil
+	})
+}
@@ -3,6 +3,9 @@ package dataservices
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/credentials"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
 )
 
 const (
@@ -16,10 +19,25 @@ const (
 	Redis
 )
 
-func NewService(c contracts.BaseClientInterface, serviceID int) *ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Credentials credentials.ClientWithResponsesInterface
+	Instances   instances.ClientWithResponsesInterface
+	Offerings   offerings.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface, serviceID int) *Service {
 	url := GetBaseURLs(serviceID).Get()
-	nc, _ := NewClient(url, WithHTTPClient(c))
-	return nc
+	nc, _ := NewClient(url, WithHTTPClient(contracts.WithUserAgent(c, serviceName(serviceID), "v1.0")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Credentials: nc.Credentials,
+		Instances:   nc.Instances,
+		Offerings:   nc.Offerings,
+	}
 }
 
 func GetBaseURLs(serviceID int) baseurl.BaseURL {
@@ -42,6 +60,30 @@ func GetBaseURLs(serviceID int) baseurl.BaseURL {
 	return baseurl.BaseURL{}
 }
 
+// serviceName returns the name of the DSA service
+// used to identify the service in the User-Agent header
+func serviceName(serviceID int) string {
+	switch serviceID {
+	case ElasticSearch:
+		return "elasticsearch"
+	case LogMe:
+		return "logme"
+	case MariaDB:
+		return "mariadb"
+	case MongoDB:
+		return "mongodb"
+	case Opensearch:
+		return "opensearch"
+	case PostgresDB:
+		return "postgresql"
+	case RabbitMQ:
+		return "rabbitmq"
+	case Redis:
+		return "redis"
+	}
+	return "data-services"
+}
+
 func setElasticSearchURLs() baseurl.BaseURL {
 	return baseurl.New(
 		"elasticsearch",
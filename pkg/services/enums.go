@@ -0,0 +1,38 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package services
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of CheckStatus
+func (CheckStatus) Values() []CheckStatus {
+	return []CheckStatus{
+		CHECK_OK,
+		CHECK_FAILED,
+		CHECK_SKIPPED,
+	}
+}
+
+// IsValid reports whether e is one of the values of CheckStatus
+func (e CheckStatus) IsValid() bool {
+	switch e {
+	case CHECK_OK, CHECK_FAILED, CHECK_SKIPPED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CheckStatus) String() string {
+	return string(e)
+}
+
+// ParseCheckStatus returns s as CheckStatus, or a *validate.EnumError if s isn't one of its values
+func ParseCheckStatus(s string) (CheckStatus, error) {
+	return validate.ParseEnum[CheckStatus](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CheckStatus) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
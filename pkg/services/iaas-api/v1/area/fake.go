@@ -0,0 +1,183 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package area
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	V1ListAreasFunc                      func(ctx context.Context, organizationID V1UUID, reqEditors ...RequestEditorFn) (*V1ListAreasResponse, error)
+	V1CreateAreaWithBodyFunc             func(ctx context.Context, organizationID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1CreateAreaResponse, error)
+	V1CreateAreaFunc                     func(ctx context.Context, organizationID V1UUID, body V1CreateAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1CreateAreaResponse, error)
+	V1DeleteAreaFunc                     func(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteAreaResponse, error)
+	V1GetAreaFunc                        func(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1GetAreaResponse, error)
+	V1UpdateAreaWithBodyFunc             func(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1UpdateAreaResponse, error)
+	V1UpdateAreaFunc                     func(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1UpdateAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1UpdateAreaResponse, error)
+	V1ListNetworkRangesOfAreaFunc        func(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1ListNetworkRangesOfAreaResponse, error)
+	V1AddNetworkRangesToAreaWithBodyFunc func(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AddNetworkRangesToAreaResponse, error)
+	V1AddNetworkRangesToAreaFunc         func(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1AddNetworkRangesToAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AddNetworkRangesToAreaResponse, error)
+	V1DeleteNetworkRangeFromAreaFunc     func(ctx context.Context, organizationID V1UUID, areaID V1UUID, networkRangeID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteNetworkRangeFromAreaResponse, error)
+	V1GetNetworkRangeOfAreaFunc          func(ctx context.Context, organizationID V1UUID, areaID V1UUID, networkRangeID V1UUID, reqEditors ...RequestEditorFn) (*V1GetNetworkRangeOfAreaResponse, error)
+	V1GetProjectsUsingAreaFunc           func(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1GetProjectsUsingAreaResponse, error)
+	V1ListRoutesOfAreaFunc               func(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1ListRoutesOfAreaResponse, error)
+	V1AddRoutesToAreaWithBodyFunc        func(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AddRoutesToAreaResponse, error)
+	V1AddRoutesToAreaFunc                func(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1AddRoutesToAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AddRoutesToAreaResponse, error)
+	V1DeleteRouteFromAreaFunc            func(ctx context.Context, organizationID V1UUID, areaID V1UUID, routeID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteRouteFromAreaResponse, error)
+	V1GetRouteOfAreaFunc                 func(ctx context.Context, organizationID V1UUID, areaID V1UUID, routeID V1UUID, reqEditors ...RequestEditorFn) (*V1GetRouteOfAreaResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// V1ListAreas calls V1ListAreasFunc
+func (f *Fake) V1ListAreas(ctx context.Context, organizationID V1UUID, reqEditors ...RequestEditorFn) (*V1ListAreasResponse, error) {
+	if f.V1ListAreasFunc == nil {
+		return nil, notFaked("V1ListAreas")
+	}
+	return f.V1ListAreasFunc(ctx, organizationID, reqEditors...)
+}
+
+// V1CreateAreaWithBody calls V1CreateAreaWithBodyFunc
+func (f *Fake) V1CreateAreaWithBody(ctx context.Context, organizationID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1CreateAreaResponse, error) {
+	if f.V1CreateAreaWithBodyFunc == nil {
+		return nil, notFaked("V1CreateAreaWithBody")
+	}
+	return f.V1CreateAreaWithBodyFunc(ctx, organizationID, contentType, body, reqEditors...)
+}
+
+// V1CreateArea calls V1CreateAreaFunc
+func (f *Fake) V1CreateArea(ctx context.Context, organizationID V1UUID, body V1CreateAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1CreateAreaResponse, error) {
+	if f.V1CreateAreaFunc == nil {
+		return nil, notFaked("V1CreateArea")
+	}
+	return f.V1CreateAreaFunc(ctx, organizationID, body, reqEditors...)
+}
+
+// V1DeleteArea calls V1DeleteAreaFunc
+func (f *Fake) V1DeleteArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteAreaResponse, error) {
+	if f.V1DeleteAreaFunc == nil {
+		return nil, notFaked("V1DeleteArea")
+	}
+	return f.V1DeleteAreaFunc(ctx, organizationID, areaID, reqEditors...)
+}
+
+// V1GetArea calls V1GetAreaFunc
+func (f *Fake) V1GetArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1GetAreaResponse, error) {
+	if f.V1GetAreaFunc == nil {
+		return nil, notFaked("V1GetArea")
+	}
+	return f.V1GetAreaFunc(ctx, organizationID, areaID, reqEditors...)
+}
+
+// V1UpdateAreaWithBody calls V1UpdateAreaWithBodyFunc
+func (f *Fake) V1UpdateAreaWithBody(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1UpdateAreaResponse, error) {
+	if f.V1UpdateAreaWithBodyFunc == nil {
+		return nil, notFaked("V1UpdateAreaWithBody")
+	}
+	return f.V1UpdateAreaWithBodyFunc(ctx, organizationID, areaID, contentType, body, reqEditors...)
+}
+
+// V1UpdateArea calls V1UpdateAreaFunc
+func (f *Fake) V1UpdateArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1UpdateAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1UpdateAreaResponse, error) {
+	if f.V1UpdateAreaFunc == nil {
+		return nil, notFaked("V1UpdateArea")
+	}
+	return f.V1UpdateAreaFunc(ctx, organizationID, areaID, body, reqEditors...)
+}
+
+// V1ListNetworkRangesOfArea calls V1ListNetworkRangesOfAreaFunc
+func (f *Fake) V1ListNetworkRangesOfArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1ListNetworkRangesOfAreaResponse, error) {
+	if f.V1ListNetworkRangesOfAreaFunc == nil {
+		return nil, notFaked("V1ListNetworkRangesOfArea")
+	}
+	return f.V1ListNetworkRangesOfAreaFunc(ctx, organizationID, areaID, reqEditors...)
+}
+
+// V1AddNetworkRangesToAreaWithBody calls V1AddNetworkRangesToAreaWithBodyFunc
+func (f *Fake) V1AddNetworkRangesToAreaWithBody(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AddNetworkRangesToAreaResponse, error) {
+	if f.V1AddNetworkRangesToAreaWithBodyFunc == nil {
+		return nil, notFaked("V1AddNetworkRangesToAreaWithBody")
+	}
+	return f.V1AddNetworkRangesToAreaWithBodyFunc(ctx, organizationID, areaID, contentType, body, reqEditors...)
+}
+
+// V1AddNetworkRangesToArea calls V1AddNetworkRangesToAreaFunc
+func (f *Fake) V1AddNetworkRangesToArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1AddNetworkRangesToAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AddNetworkRangesToAreaResponse, error) {
+	if f.V1AddNetworkRangesToAreaFunc == nil {
+		return nil, notFaked("V1AddNetworkRangesToArea")
+	}
+	return f.V1AddNetworkRangesToAreaFunc(ctx, organizationID, areaID, body, reqEditors...)
+}
+
+// V1DeleteNetworkRangeFromArea calls V1DeleteNetworkRangeFromAreaFunc
+func (f *Fake) V1DeleteNetworkRangeFromArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, networkRangeID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteNetworkRangeFromAreaResponse, error) {
+	if f.V1DeleteNetworkRangeFromAreaFunc == nil {
+		return nil, notFaked("V1DeleteNetworkRangeFromArea")
+	}
+	return f.V1DeleteNetworkRangeFromAreaFunc(ctx, organizationID, areaID, networkRangeID, reqEditors...)
+}
+
+// V1GetNetworkRangeOfArea calls V1GetNetworkRangeOfAreaFunc
+func (f *Fake) V1GetNetworkRangeOfArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, networkRangeID V1UUID, reqEditors ...RequestEditorFn) (*V1GetNetworkRangeOfAreaResponse, error) {
+	if f.V1GetNetworkRangeOfAreaFunc == nil {
+		return nil, notFaked("V1GetNetworkRangeOfArea")
+	}
+	return f.V1GetNetworkRangeOfAreaFunc(ctx, organizationID, areaID, networkRangeID, reqEditors...)
+}
+
+// V1GetProjectsUsingArea calls V1GetProjectsUsingAreaFunc
+func (f *Fake) V1GetProjectsUsingArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1GetProjectsUsingAreaResponse, error) {
+	if f.V1GetProjectsUsingAreaFunc == nil {
+		return nil, notFaked("V1GetProjectsUsingArea")
+	}
+	return f.V1GetProjectsUsingAreaFunc(ctx, organizationID, areaID, reqEditors...)
+}
+
+// V1ListRoutesOfArea calls V1ListRoutesOfAreaFunc
+func (f *Fake) V1ListRoutesOfArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, reqEditors ...RequestEditorFn) (*V1ListRoutesOfAreaResponse, error) {
+	if f.V1ListRoutesOfAreaFunc == nil {
+		return nil, notFaked("V1ListRoutesOfArea")
+	}
+	return f.V1ListRoutesOfAreaFunc(ctx, organizationID, areaID, reqEditors...)
+}
+
+// V1AddRoutesToAreaWithBody calls V1AddRoutesToAreaWithBodyFunc
+func (f *Fake) V1AddRoutesToAreaWithBody(ctx context.Context, organizationID V1UUID, areaID V1UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AddRoutesToAreaResponse, error) {
+	if f.V1AddRoutesToAreaWithBodyFunc == nil {
+		return nil, notFaked("V1AddRoutesToAreaWithBody")
+	}
+	return f.V1AddRoutesToAreaWithBodyFunc(ctx, organizationID, areaID, contentType, body, reqEditors...)
+}
+
+// V1AddRoutesToArea calls V1AddRoutesToAreaFunc
+func (f *Fake) V1AddRoutesToArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, body V1AddRoutesToAreaJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AddRoutesToAreaResponse, error) {
+	if f.V1AddRoutesToAreaFunc == nil {
+		return nil, notFaked("V1AddRoutesToArea")
+	}
+	return f.V1AddRoutesToAreaFunc(ctx, organizationID, areaID, body, reqEditors...)
+}
+
+// V1DeleteRouteFromArea calls V1DeleteRouteFromAreaFunc
+func (f *Fake) V1DeleteRouteFromArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, routeID V1UUID, reqEditors ...RequestEditorFn) (*V1DeleteRouteFromAreaResponse, error) {
+	if f.V1DeleteRouteFromAreaFunc == nil {
+		return nil, notFaked("V1DeleteRouteFromArea")
+	}
+	return f.V1DeleteRouteFromAreaFunc(ctx, organizationID, areaID, routeID, reqEditors...)
+}
+
+// V1GetRouteOfArea calls V1GetRouteOfAreaFunc
+func (f *Fake) V1GetRouteOfArea(ctx context.Context, organizationID V1UUID, areaID V1UUID, routeID V1UUID, reqEditors ...RequestEditorFn) (*V1GetRouteOfAreaResponse, error) {
+	if f.V1GetRouteOfAreaFunc == nil {
+		return nil, notFaked("V1GetRouteOfArea")
+	}
+	return f.V1GetRouteOfAreaFunc(ctx, organizationID, areaID, routeID, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
@@ -3,6 +3,16 @@ package iaas
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/backups"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/flavors"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/images"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/keypairs"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/probes"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/project"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/request"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/servers"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,7 +20,36 @@ var BaseURLs = baseurl.New(
 	"https://iaas.api.eu01.stackit.cloud/",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(c))
-	return nc
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Area     area.ClientWithResponsesInterface
+	Backups  backups.ClientWithResponsesInterface
+	Flavors  flavors.ClientWithResponsesInterface
+	Images   images.ClientWithResponsesInterface
+	Keypairs keypairs.ClientWithResponsesInterface
+	Network  network.ClientWithResponsesInterface
+	Probes   probes.ClientWithResponsesInterface
+	Project  project.ClientWithResponsesInterface
+	Request  request.ClientWithResponsesInterface
+	Servers  servers.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
+	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(contracts.WithUserAgent(c, "iaas", "v1")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Area:     nc.Area,
+		Backups:  nc.Backups,
+		Flavors:  nc.Flavors,
+		Images:   nc.Images,
+		Keypairs: nc.Keypairs,
+		Network:  nc.Network,
+		Probes:   nc.Probes,
+		Project:  nc.Project,
+		Request:  nc.Request,
+		Servers:  nc.Servers,
+	}
 }
@@ -11,18 +11,19 @@ import (
 )
 
 // WaitHandler wait for the network to be created and return it.
-func (*V1CreateNetworkResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID openapiTypes.UUID, name string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// returned value is the created *V1Network
+func (*V1CreateNetworkResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID openapiTypes.UUID, name string) *wait.Handler[*V1Network] {
+	return wait.NewHandler(func() (res *V1Network, done bool, err error) {
 		resp, err := c.V1ListNetworksInProject(ctx, projectID)
 		if err != nil {
-			return nil, false, err
+			return nil, false, wait.NewRequestError(resp, err)
 		}
 
 		if resp.JSON200 != nil && len(resp.JSON200.Items) > 0 {
 			for _, n := range resp.JSON200.Items {
 				if n.Name == name {
 					// the network is created successfully
-					return n, true, nil
+					return &n, true, nil
 				}
 			}
 
@@ -47,15 +48,16 @@ func (*V1CreateNetworkResponse) WaitHandler(ctx context.Context, c *ClientWithRe
 
 		// in all other cases we will retry the request until the network is not created or an error occurred.
 		return nil, false, nil
-	})
+	}).Describe("iaas-api", "network.create", "created", map[string]string{"projectID": projectID.String(), "name": name})
 }
 
 // WaitHandler wait for the network to be deleted
-func (*V1DeleteNetworkResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, networkID openapiTypes.UUID) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
+// returned value is the *V1GetNetworkResponse confirming the deletion
+func (*V1DeleteNetworkResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, networkID openapiTypes.UUID) *wait.Handler[*V1GetNetworkResponse] {
+	return wait.NewHandler(func() (res *V1GetNetworkResponse, done bool, err error) {
 		resp, err := c.V1GetNetwork(ctx, projectID, networkID)
 		if err != nil {
-			return nil, false, err
+			return nil, false, wait.NewRequestError(resp, err)
 		}
 
 		if resp.JSON404 != nil {
@@ -85,5 +87,5 @@ func (*V1DeleteNetworkResponse) WaitHandler(ctx context.Context, c *ClientWithRe
 
 		// in all other cases we will retry the request until the network is not deleted or an error occurred.
 		return nil, false, nil
-	})
+	}).Describe("iaas-api", "network.delete", "deleted", map[string]string{"projectID": projectID.String(), "networkID": networkID.String()})
 }
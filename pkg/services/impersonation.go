@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultImpersonationTTLDays     = 1
+	DefaultImpersonationMaxAge      = time.Hour
+	DefaultImpersonationRenewBefore = 5 * time.Minute
+	DefaultImpersonationRevokeAfter = time.Minute
+)
+
+// ImpersonationFlowConfig is the impersonation flow config
+type ImpersonationFlowConfig struct {
+	// ProjectID is the project the target service account belongs to
+	ProjectID string
+
+	// ServiceAccountEmail is the email of the target service account
+	ServiceAccountEmail string
+
+	// TTLDays is the validity of minted access tokens in days (minimum 1)
+	TTLDays int
+
+	// MaxAge is the duration after which a token is renewed, even if it is still valid
+	// replaced tokens are revoked, keeping the effective token lifetime short
+	MaxAge time.Duration
+
+	// RenewBefore is the duration before expiry in which a token is renewed
+	RenewBefore time.Duration
+
+	// RevokeAfter is the grace period after which a replaced token is revoked,
+	// so requests still using it can complete. remaining tokens are revoked on Close
+	RevokeAfter time.Duration
+
+	ClientRetry       *clients.RetryConfig
+	EnableTraceparent bool
+	UserAgent         string
+	Transport         http.RoundTripper // HTTP transport, http.DefaultTransport is used if nil
+}
+
+// ImpersonationFlow authenticates requests with access tokens of a target service account
+// which are minted by a privileged service account using the service accounts API.
+// tokens are cached, renewed automatically and shared between clones of the flow
+type ImpersonationFlow struct {
+	sa     serviceaccounts.ClientWithResponsesInterface
+	config *ImpersonationFlowConfig
+	state  *impersonationState
+}
+
+// impersonationState holds the current token
+// and the token flow performing requests with it
+type impersonationState struct {
+	mu       sync.Mutex
+	token    *serviceaccounts.AccessTokenV2
+	mintedAt time.Time
+	flow     *clients.TokenFlow
+	minting  chan struct{}   // closed when the running mint is done, nil if there is none
+	replaced []replacedToken // tokens waiting to be revoked
+}
+
+// replacedToken is a replaced token and the time it's revoked at
+type replacedToken struct {
+	id       openapiTypes.UUID
+	revokeAt time.Time
+}
+
+// NewImpersonationFlow creates a new impersonation flow
+// sa is the service accounts client of the privileged service account
+func NewImpersonationFlow(sa serviceaccounts.ClientWithResponsesInterface, cfg ImpersonationFlowConfig) (*ImpersonationFlow, error) {
+	if sa == nil {
+		return nil, errors.New("service accounts client must be specified")
+	}
+	if err := validate.ProjectID(cfg.ProjectID); err != nil {
+		return nil, err
+	}
+	if cfg.ServiceAccountEmail == "" {
+		return nil, errors.New("target service account email must be specified")
+	}
+	if cfg.TTLDays < 1 {
+		cfg.TTLDays = DefaultImpersonationTTLDays
+	}
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = DefaultImpersonationMaxAge
+	}
+	if cfg.RenewBefore == 0 {
+		cfg.RenewBefore = DefaultImpersonationRenewBefore
+	}
+	if cfg.RevokeAfter == 0 {
+		cfg.RevokeAfter = DefaultImpersonationRevokeAfter
+	}
+	return &ImpersonationFlow{
+		sa:     sa,
+		config: &cfg,
+		state:  &impersonationState{},
+	}, nil
+}
+
+// Impersonate returns services that perform requests as the given target service account
+// using short-lived access tokens minted by the current client
+func (s *Services) Impersonate(projectID, serviceAccountEmail string, cfg ...ImpersonationFlowConfig) (*Services, error) {
+	c := ImpersonationFlowConfig{}
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	c.ProjectID = projectID
+	c.ServiceAccountEmail = serviceAccountEmail
+	flow, err := NewImpersonationFlow(s.ServiceAccounts, c)
+	if err != nil {
+		return nil, err
+	}
+	return Init(flow)
+}
+
+// GetConfig returns the flow configuration
+func (c *ImpersonationFlow) GetConfig() ImpersonationFlowConfig {
+	if c.config == nil {
+		return ImpersonationFlowConfig{}
+	}
+	return *c.config
+}
+
+// GetServiceAccountEmail returns the target service account email
+func (c *ImpersonationFlow) GetServiceAccountEmail() string {
+	return c.GetConfig().ServiceAccountEmail
+}
+
+// Clone returns the flow
+// clones share the token cache so a token is only minted once for all services
+func (c *ImpersonationFlow) Clone() interface{} {
+	return c
+}
+
+// Do performs the request as the target service account
+func (c *ImpersonationFlow) Do(req *http.Request) (*http.Response, error) {
+	flow, err := c.getTokenFlow(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	return flow.Do(req)
+}
+
+// GetAccessToken returns the current access token of the target service account
+// a new token is minted if needed
+func (c *ImpersonationFlow) GetAccessToken(ctx context.Context) (string, error) {
+	flow, err := c.getTokenFlow(ctx)
+	if err != nil {
+		return "", err
+	}
+	return flow.GetConfig().ServiceAccountToken, nil
+}
+
+// Close revokes the current access token and the replaced ones
+func (c *ImpersonationFlow) Close(ctx context.Context) error {
+	c.state.mu.Lock()
+	ids := []openapiTypes.UUID{}
+	if c.state.token != nil {
+		ids = append(ids, c.state.token.ID)
+	}
+	for _, t := range c.state.replaced {
+		ids = append(ids, t.id)
+	}
+	c.state.token = nil
+	c.state.flow = nil
+	c.state.replaced = nil
+	c.state.mu.Unlock()
+
+	var err error
+	for _, id := range ids {
+		if rerr := c.revoke(ctx, id); rerr != nil && err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+// getTokenFlow returns a token flow authenticated with a valid access token
+// the token is renewed when it reached its max age or is about to expire
+// tokens are minted without holding the lock, concurrent callers wait for the running mint
+func (c *ImpersonationFlow) getTokenFlow(ctx context.Context) (*clients.TokenFlow, error) {
+	c.revokeReplaced(ctx)
+	for {
+		c.state.mu.Lock()
+		if c.state.flow != nil && !c.needsRenewal(time.Now()) {
+			flow := c.state.flow
+			c.state.mu.Unlock()
+			return flow, nil
+		}
+		if minting := c.state.minting; minting != nil {
+			c.state.mu.Unlock()
+			select {
+			case <-minting:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		minting := make(chan struct{})
+		c.state.minting = minting
+		c.state.mu.Unlock()
+
+		token, flow, err := c.newTokenFlow(ctx)
+
+		c.state.mu.Lock()
+		c.state.minting = nil
+		close(minting)
+		if err != nil {
+			c.state.mu.Unlock()
+			return nil, err
+		}
+		// the replaced token is revoked after a grace period, as requests may still use it
+		if c.state.token != nil {
+			c.state.replaced = append(c.state.replaced, replacedToken{
+				id:       c.state.token.ID,
+				revokeAt: time.Now().Add(c.config.RevokeAfter),
+			})
+		}
+		c.state.token = token
+		c.state.mintedAt = time.Now()
+		c.state.flow = flow
+		c.state.mu.Unlock()
+		return flow, nil
+	}
+}
+
+// newTokenFlow mints a new token and returns it with a token flow using it
+func (c *ImpersonationFlow) newTokenFlow(ctx context.Context) (*serviceaccounts.AccessTokenV2, *clients.TokenFlow, error) {
+	token, err := c.mint(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to mint access token for impersonation")
+	}
+	flow := &clients.TokenFlow{}
+	if err := flow.Init(ctx, clients.TokenFlowConfig{
+		ServiceAccountEmail: c.config.ServiceAccountEmail,
+		ServiceAccountToken: token.Token,
+		ClientRetry:         c.config.ClientRetry,
+		EnableTraceparent:   c.config.EnableTraceparent,
+		UserAgent:           c.config.UserAgent,
+		Transport:           c.config.Transport,
+	}); err != nil {
+		return nil, nil, err
+	}
+	return token, flow, nil
+}
+
+// revokeReplaced revokes the replaced tokens whose grace period is over
+// failures are ignored as the tokens expire anyway
+func (c *ImpersonationFlow) revokeReplaced(ctx context.Context) {
+	now := time.Now()
+	c.state.mu.Lock()
+	due := []openapiTypes.UUID{}
+	pending := c.state.replaced[:0]
+	for _, t := range c.state.replaced {
+		if now.Before(t.revokeAt) {
+			pending = append(pending, t)
+			continue
+		}
+		due = append(due, t.id)
+	}
+	c.state.replaced = pending
+	c.state.mu.Unlock()
+
+	for _, id := range due {
+		_ = c.revoke(ctx, id)
+	}
+}
+
+// needsRenewal returns true if the current token reached its max age or is about to expire
+func (c *ImpersonationFlow) needsRenewal(now time.Time) bool {
+	if c.state.token == nil {
+		return true
+	}
+	if now.Sub(c.state.mintedAt) >= c.config.MaxAge {
+		return true
+	}
+	return c.state.token.ValidUntil.Sub(now) <= c.config.RenewBefore
+}
+
+// mint creates a new access token for the target service account
+func (c *ImpersonationFlow) mint(ctx context.Context) (*serviceaccounts.AccessTokenV2, error) {
+	res, err := c.sa.CreateAccessTokens(
+		ctx,
+		c.config.ProjectID,
+		openapiTypes.Email(c.config.ServiceAccountEmail),
+		serviceaccounts.CreateAccessTokensJSONRequestBody{TtlDays: c.config.TTLDays},
+	)
+	if err = validate.Response(res, err, "JSON201"); err != nil {
+		return nil, err
+	}
+	if res.JSON201.Token == "" {
+		return nil, fmt.Errorf("received an empty access token for %s", c.config.ServiceAccountEmail)
+	}
+	return res.JSON201, nil
+}
+
+// revoke deletes a given access token of the target service account
+func (c *ImpersonationFlow) revoke(ctx context.Context, tokenID openapiTypes.UUID) error {
+	res, err := c.sa.DeleteAccessTokens(
+		ctx,
+		c.config.ProjectID,
+		openapiTypes.Email(c.config.ServiceAccountEmail),
+		tokenID,
+	)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
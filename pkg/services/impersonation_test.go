@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/stackittest"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	projectID = "5dae0612-f5b1-4615-b7ca-b18796aa7e78"
+	target    = "target@sa.stackit.cloud"
+)
+
+// newImpersonationFlow returns an impersonation flow of the target service account against srv
+func newImpersonationFlow(t *testing.T, srv *stackittest.Server, cfg services.ImpersonationFlowConfig) *services.ImpersonationFlow {
+	c, err := srv.Client(context.Background())
+	require.NoError(t, err)
+	cfg.ProjectID = projectID
+	cfg.ServiceAccountEmail = target
+	cfg.Transport = srv.Transport()
+	flow, err := services.NewImpersonationFlow(c.ServiceAccounts, cfg)
+	require.NoError(t, err)
+	return flow
+}
+
+// accepted reports whether srv accepts the access token
+func accepted(t *testing.T, srv *stackittest.Server, token string) bool {
+	ctx := context.Background()
+	tf := &clients.TokenFlow{}
+	require.NoError(t, tf.Init(ctx, clients.TokenFlowConfig{ServiceAccountEmail: target, ServiceAccountToken: token, Transport: srv.Transport()}))
+	c, err := services.Init(tf)
+	require.NoError(t, err)
+	res, err := c.Membership.GetUserMemberships(ctx, target, &membership.GetUserMembershipsParams{})
+	return validate.Response(res, err) == nil
+}
+
+func TestImpersonationFlow_Mint(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	flow := newImpersonationFlow(t, srv, services.ImpersonationFlowConfig{})
+
+	token, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	assert.True(t, accepted(t, srv, token))
+
+	again, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, token, again, "a valid token should be reused")
+
+	c, err := services.Init(flow)
+	require.NoError(t, err)
+	res, err := c.Membership.GetUserMemberships(ctx, target, &membership.GetUserMembershipsParams{})
+	assert.NoError(t, validate.Response(res, err), "requests should be authenticated with the minted token")
+}
+
+func TestImpersonationFlow_MintConcurrently(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	flow := newImpersonationFlow(t, srv, services.ImpersonationFlowConfig{})
+
+	tokens := make([]string, 10)
+	wg := sync.WaitGroup{}
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token, err := flow.GetAccessToken(context.Background())
+			assert.NoError(t, err)
+			tokens[i] = token
+		}(i)
+	}
+	wg.Wait()
+	for _, token := range tokens {
+		assert.Equal(t, tokens[0], token, "a token should only be minted once")
+	}
+}
+
+func TestImpersonationFlow_Renewal(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	flow := newImpersonationFlow(t, srv, services.ImpersonationFlowConfig{
+		MaxAge:      100 * time.Millisecond,
+		RevokeAfter: 50 * time.Millisecond,
+	})
+
+	old, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+
+	time.Sleep(110 * time.Millisecond)
+	renewed, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, old, renewed, "a token should be renewed after its max age")
+	assert.True(t, accepted(t, srv, old), "a replaced token should be valid during the grace period")
+
+	time.Sleep(60 * time.Millisecond)
+	current, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, renewed, current)
+	assert.False(t, accepted(t, srv, old), "a replaced token should be revoked after the grace period")
+	assert.True(t, accepted(t, srv, current))
+}
+
+func TestImpersonationFlow_Close(t *testing.T) {
+	srv := stackittest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	flow := newImpersonationFlow(t, srv, services.ImpersonationFlowConfig{
+		MaxAge:      50 * time.Millisecond,
+		RevokeAfter: time.Hour,
+	})
+
+	old, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	time.Sleep(60 * time.Millisecond)
+	current, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, old, current)
+
+	require.NoError(t, flow.Close(ctx))
+	assert.False(t, accepted(t, srv, old), "replaced tokens should be revoked on close")
+	assert.False(t, accepted(t, srv, current), "the current token should be revoked on close")
+	assert.NoError(t, flow.Close(ctx), "closing twice should be a no-op")
+
+	again, err := flow.GetAccessToken(ctx)
+	require.NoError(t, err)
+	assert.True(t, accepted(t, srv, again), "a new token should be minted after close")
+}
@@ -0,0 +1,184 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package cluster
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of CRIName
+func (CRIName) Values() []CRIName {
+	return []CRIName{
+		CONTAINERD,
+		DOCKER,
+	}
+}
+
+// IsValid reports whether e is one of the values of CRIName
+func (e CRIName) IsValid() bool {
+	switch e {
+	case CONTAINERD, DOCKER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CRIName) String() string {
+	return string(e)
+}
+
+// ParseCRIName returns s as CRIName, or a *validate.EnumError if s isn't one of its values
+func ParseCRIName(s string) (CRIName, error) {
+	return validate.ParseEnum[CRIName](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CRIName) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ClusterStatusState
+func (ClusterStatusState) Values() []ClusterStatusState {
+	return []ClusterStatusState{
+		STATE_CREATING,
+		STATE_DELETING,
+		STATE_HEALTHY,
+		STATE_HIBERNATED,
+		STATE_HIBERNATING,
+		STATE_RECONCILING,
+		STATE_UNHEALTHY,
+		STATE_UNSPECIFIED,
+		STATE_WAKINGUP,
+	}
+}
+
+// IsValid reports whether e is one of the values of ClusterStatusState
+func (e ClusterStatusState) IsValid() bool {
+	switch e {
+	case STATE_CREATING, STATE_DELETING, STATE_HEALTHY, STATE_HIBERNATED, STATE_HIBERNATING, STATE_RECONCILING, STATE_UNHEALTHY, STATE_UNSPECIFIED, STATE_WAKINGUP:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ClusterStatusState) String() string {
+	return string(e)
+}
+
+// ParseClusterStatusState returns s as ClusterStatusState, or a *validate.EnumError if s isn't one of its values
+func ParseClusterStatusState(s string) (ClusterStatusState, error) {
+	return validate.ParseEnum[ClusterStatusState](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ClusterStatusState) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of CredentialsRotationPhase
+func (CredentialsRotationPhase) Values() []CredentialsRotationPhase {
+	return []CredentialsRotationPhase{
+		COMPLETED,
+		COMPLETING,
+		NEVER,
+		PREPARED,
+		PREPARING,
+	}
+}
+
+// IsValid reports whether e is one of the values of CredentialsRotationPhase
+func (e CredentialsRotationPhase) IsValid() bool {
+	switch e {
+	case COMPLETED, COMPLETING, NEVER, PREPARED, PREPARING:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e CredentialsRotationPhase) String() string {
+	return string(e)
+}
+
+// ParseCredentialsRotationPhase returns s as CredentialsRotationPhase, or a *validate.EnumError if s isn't one of its values
+func ParseCredentialsRotationPhase(s string) (CredentialsRotationPhase, error) {
+	return validate.ParseEnum[CredentialsRotationPhase](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *CredentialsRotationPhase) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of RuntimeErrorCode
+func (RuntimeErrorCode) Values() []RuntimeErrorCode {
+	return []RuntimeErrorCode{
+		SKE_API_SERVER_ERROR,
+		SKE_ARGUS_INSTANCE_NOT_FOUND,
+		SKE_CONFIGURATION_PROBLEM,
+		SKE_INFRA_ERROR,
+		SKE_QUOTA_EXCEEDED,
+		SKE_RATE_LIMITS,
+		SKE_REMAINING_RESOURCES,
+		SKE_TMP_AUTH_ERROR,
+		SKE_UNREADY_NODES,
+		SKE_UNSPECIFIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RuntimeErrorCode
+func (e RuntimeErrorCode) IsValid() bool {
+	switch e {
+	case SKE_API_SERVER_ERROR, SKE_ARGUS_INSTANCE_NOT_FOUND, SKE_CONFIGURATION_PROBLEM, SKE_INFRA_ERROR, SKE_QUOTA_EXCEEDED, SKE_RATE_LIMITS, SKE_REMAINING_RESOURCES, SKE_TMP_AUTH_ERROR, SKE_UNREADY_NODES, SKE_UNSPECIFIED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e RuntimeErrorCode) String() string {
+	return string(e)
+}
+
+// ParseRuntimeErrorCode returns s as RuntimeErrorCode, or a *validate.EnumError if s isn't one of its values
+func ParseRuntimeErrorCode(s string) (RuntimeErrorCode, error) {
+	return validate.ParseEnum[RuntimeErrorCode](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *RuntimeErrorCode) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of TaintEffect
+func (TaintEffect) Values() []TaintEffect {
+	return []TaintEffect{
+		NO_EXECUTE,
+		NO_SCHEDULE,
+		PREFER_NO_SCHEDULE,
+	}
+}
+
+// IsValid reports whether e is one of the values of TaintEffect
+func (e TaintEffect) IsValid() bool {
+	switch e {
+	case NO_EXECUTE, NO_SCHEDULE, PREFER_NO_SCHEDULE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e TaintEffect) String() string {
+	return string(e)
+}
+
+// ParseTaintEffect returns s as TaintEffect, or a *validate.EnumError if s isn't one of its values
+func ParseTaintEffect(s string) (TaintEffect, error) {
+	return validate.ParseEnum[TaintEffect](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *TaintEffect) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,29 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the clusters of a project
+// clusters are keyed by name
+func NewWatcher(c ClientWithResponsesInterface, projectID string) *watch.Watcher[Cluster] {
+	return watch.New(func(ctx context.Context) (map[string]Cluster, error) {
+		resp, err := c.List(ctx, projectID)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]Cluster{}
+		if resp.JSON200.Items == nil {
+			return items, nil
+		}
+		for _, cl := range *resp.JSON200.Items {
+			if cl.Name != nil {
+				items[*cl.Name] = cl
+			}
+		}
+		return items, nil
+	})
+}
@@ -0,0 +1,45 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package credentials
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of RuntimeErrorCode
+func (RuntimeErrorCode) Values() []RuntimeErrorCode {
+	return []RuntimeErrorCode{
+		SKE_API_SERVER_ERROR,
+		SKE_ARGUS_INSTANCE_NOT_FOUND,
+		SKE_CONFIGURATION_PROBLEM,
+		SKE_INFRA_ERROR,
+		SKE_QUOTA_EXCEEDED,
+		SKE_RATE_LIMITS,
+		SKE_REMAINING_RESOURCES,
+		SKE_TMP_AUTH_ERROR,
+		SKE_UNREADY_NODES,
+		SKE_UNSPECIFIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of RuntimeErrorCode
+func (e RuntimeErrorCode) IsValid() bool {
+	switch e {
+	case SKE_API_SERVER_ERROR, SKE_ARGUS_INSTANCE_NOT_FOUND, SKE_CONFIGURATION_PROBLEM, SKE_INFRA_ERROR, SKE_QUOTA_EXCEEDED, SKE_RATE_LIMITS, SKE_REMAINING_RESOURCES, SKE_TMP_AUTH_ERROR, SKE_UNREADY_NODES, SKE_UNSPECIFIED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e RuntimeErrorCode) String() string {
+	return string(e)
+}
+
+// ParseRuntimeErrorCode returns s as RuntimeErrorCode, or a *validate.EnumError if s isn't one of its values
+func ParseRuntimeErrorCode(s string) (RuntimeErrorCode, error) {
+	return validate.ParseEnum[RuntimeErrorCode](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *RuntimeErrorCode) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
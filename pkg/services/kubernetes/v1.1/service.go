@@ -3,6 +3,11 @@ package kubernetes
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/credentials"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/operation"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/project"
+	provideroptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/provider-options"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,7 +15,26 @@ var BaseURLs = baseurl.New(
 	"https://ske.api.eu01.stackit.cloud/",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(c))
-	return nc
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Cluster         cluster.ClientWithResponsesInterface
+	Credentials     credentials.ClientWithResponsesInterface
+	Operation       operation.ClientWithResponsesInterface
+	ProviderOptions provideroptions.ClientWithResponsesInterface
+	Project         project.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
+	nc, _ := NewClient(BaseURLs.Get(), WithHTTPClient(contracts.WithUserAgent(c, "kubernetes", "v1.1")))
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Cluster:         nc.Cluster,
+		Credentials:     nc.Credentials,
+		Operation:       nc.Operation,
+		ProviderOptions: nc.ProviderOptions,
+		Project:         nc.Project,
+	}
 }
@@ -0,0 +1,41 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package project
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of StatusResponseStatus
+func (StatusResponseStatus) Values() []StatusResponseStatus {
+	return []StatusResponseStatus{
+		STATUS_DELETING,
+		STATUS_DISABLED,
+		STATUS_FAILED,
+		STATUS_READY,
+		STATUS_UNSPECIFIED,
+		STATUS_UPDATING,
+	}
+}
+
+// IsValid reports whether e is one of the values of StatusResponseStatus
+func (e StatusResponseStatus) IsValid() bool {
+	switch e {
+	case STATUS_DELETING, STATUS_DISABLED, STATUS_FAILED, STATUS_READY, STATUS_UNSPECIFIED, STATUS_UPDATING:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e StatusResponseStatus) String() string {
+	return string(e)
+}
+
+// ParseStatusResponseStatus returns s as StatusResponseStatus, or a *validate.EnumError if s isn't one of its values
+func ParseStatusResponseStatus(s string) (StatusResponseStatus, error) {
+	return validate.ParseEnum[StatusResponseStatus](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *StatusResponseStatus) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
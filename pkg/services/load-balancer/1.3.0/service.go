@@ -3,6 +3,8 @@ package loadbalancer
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/project"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,10 +12,23 @@ var BaseURLs = baseurl.New(
 	"https://load-balancer.api.eu01.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Project   project.ClientWithResponsesInterface
+	Instances instances.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
 	nc, _ := NewClient(
 		BaseURLs.Get(),
-		WithHTTPClient(c),
+		WithHTTPClient(contracts.WithUserAgent(c, "load-balancer", "1.3.0")),
 	)
-	return nc
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Project:   nc.Project,
+		Instances: nc.Instances,
+	}
 }
@@ -0,0 +1,138 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package instances
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of ListenerProtocol
+func (ListenerProtocol) Values() []ListenerProtocol {
+	return []ListenerProtocol{
+		PROTOCOL_TCP,
+		PROTOCOL_TCP_PROXY,
+		PROTOCOL_UDP,
+	}
+}
+
+// IsValid reports whether e is one of the values of ListenerProtocol
+func (e ListenerProtocol) IsValid() bool {
+	switch e {
+	case PROTOCOL_TCP, PROTOCOL_TCP_PROXY, PROTOCOL_UDP:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ListenerProtocol) String() string {
+	return string(e)
+}
+
+// ParseListenerProtocol returns s as ListenerProtocol, or a *validate.EnumError if s isn't one of its values
+func ParseListenerProtocol(s string) (ListenerProtocol, error) {
+	return validate.ParseEnum[ListenerProtocol](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ListenerProtocol) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of LoadBalancerErrorType
+func (LoadBalancerErrorType) Values() []LoadBalancerErrorType {
+	return []LoadBalancerErrorType{
+		TYPE_INTERNAL,
+		TYPE_QUOTA_SECGROUPRULE_EXCEEDED,
+		TYPE_QUOTA_SECGROUP_EXCEEDED,
+		TYPE_UNSPECIFIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of LoadBalancerErrorType
+func (e LoadBalancerErrorType) IsValid() bool {
+	switch e {
+	case TYPE_INTERNAL, TYPE_QUOTA_SECGROUPRULE_EXCEEDED, TYPE_QUOTA_SECGROUP_EXCEEDED, TYPE_UNSPECIFIED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e LoadBalancerErrorType) String() string {
+	return string(e)
+}
+
+// ParseLoadBalancerErrorType returns s as LoadBalancerErrorType, or a *validate.EnumError if s isn't one of its values
+func ParseLoadBalancerErrorType(s string) (LoadBalancerErrorType, error) {
+	return validate.ParseEnum[LoadBalancerErrorType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *LoadBalancerErrorType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of LoadBalancerStatus
+func (LoadBalancerStatus) Values() []LoadBalancerStatus {
+	return []LoadBalancerStatus{
+		STATUS_ERROR,
+		STATUS_PENDING,
+		STATUS_READY,
+		STATUS_TERMINATING,
+		STATUS_UNSPECIFIED,
+	}
+}
+
+// IsValid reports whether e is one of the values of LoadBalancerStatus
+func (e LoadBalancerStatus) IsValid() bool {
+	switch e {
+	case STATUS_ERROR, STATUS_PENDING, STATUS_READY, STATUS_TERMINATING, STATUS_UNSPECIFIED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e LoadBalancerStatus) String() string {
+	return string(e)
+}
+
+// ParseLoadBalancerStatus returns s as LoadBalancerStatus, or a *validate.EnumError if s isn't one of its values
+func ParseLoadBalancerStatus(s string) (LoadBalancerStatus, error) {
+	return validate.ParseEnum[LoadBalancerStatus](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *LoadBalancerStatus) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of NetworkRole
+func (NetworkRole) Values() []NetworkRole {
+	return []NetworkRole{
+		ROLE_LISTENERS_AND_TARGETS,
+	}
+}
+
+// IsValid reports whether e is one of the values of NetworkRole
+func (e NetworkRole) IsValid() bool {
+	switch e {
+	case ROLE_LISTENERS_AND_TARGETS:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e NetworkRole) String() string {
+	return string(e)
+}
+
+// ParseNetworkRole returns s as NetworkRole, or a *validate.EnumError if s isn't one of its values
+func ParseNetworkRole(s string) (NetworkRole, error) {
+	return validate.ParseEnum[NetworkRole](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *NetworkRole) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,29 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the load balancers of a project
+// load balancers are keyed by name
+func NewWatcher(c ClientWithResponsesInterface, projectID string) *watch.Watcher[LoadBalancer] {
+	return watch.New(func(ctx context.Context) (map[string]LoadBalancer, error) {
+		resp, err := c.List(ctx, projectID)
+		if err = validate.Response(resp, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]LoadBalancer{}
+		if resp.JSON200.LoadBalancers == nil {
+			return items, nil
+		}
+		for _, lb := range *resp.JSON200.LoadBalancers {
+			if lb.Name != nil {
+				items[*lb.Name] = lb
+			}
+		}
+		return items, nil
+	})
+}
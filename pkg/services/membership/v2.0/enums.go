@@ -0,0 +1,71 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package membership
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of PermissionWithInheritanceInheritance
+func (PermissionWithInheritanceInheritance) Values() []PermissionWithInheritanceInheritance {
+	return []PermissionWithInheritanceInheritance{
+		FULL,
+		NONE,
+		SINGLE,
+	}
+}
+
+// IsValid reports whether e is one of the values of PermissionWithInheritanceInheritance
+func (e PermissionWithInheritanceInheritance) IsValid() bool {
+	switch e {
+	case FULL, NONE, SINGLE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e PermissionWithInheritanceInheritance) String() string {
+	return string(e)
+}
+
+// ParsePermissionWithInheritanceInheritance returns s as PermissionWithInheritanceInheritance, or a *validate.EnumError if s isn't one of its values
+func ParsePermissionWithInheritanceInheritance(s string) (PermissionWithInheritanceInheritance, error) {
+	return validate.ParseEnum[PermissionWithInheritanceInheritance](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *PermissionWithInheritanceInheritance) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of SubjectType
+func (SubjectType) Values() []SubjectType {
+	return []SubjectType{
+		CLIENT,
+		SERVICE_ACCOUNT,
+		USER,
+	}
+}
+
+// IsValid reports whether e is one of the values of SubjectType
+func (e SubjectType) IsValid() bool {
+	switch e {
+	case CLIENT, SERVICE_ACCOUNT, USER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e SubjectType) String() string {
+	return string(e)
+}
+
+// ParseSubjectType returns s as SubjectType, or a *validate.EnumError if s isn't one of its values
+func ParseSubjectType(s string) (SubjectType, error) {
+	return validate.ParseEnum[SubjectType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *SubjectType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,291 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	GetPermissionsFunc               func(ctx context.Context, params *GetPermissionsParams, reqEditors ...RequestEditorFn) (*GetPermissionsResponse, error)
+	AddPermissionsWithBodyFunc       func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPermissionsResponse, error)
+	AddPermissionsFunc               func(ctx context.Context, body AddPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPermissionsResponse, error)
+	RemovePermissionsWithBodyFunc    func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemovePermissionsResponse, error)
+	RemovePermissionsFunc            func(ctx context.Context, body RemovePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*RemovePermissionsResponse, error)
+	GetDefaultRolesFunc              func(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDefaultRolesResponse, error)
+	AddDefaultRolesWithBodyFunc      func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDefaultRolesResponse, error)
+	AddDefaultRolesFunc              func(ctx context.Context, body AddDefaultRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDefaultRolesResponse, error)
+	RemoveDefaultRolesWithBodyFunc   func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveDefaultRolesResponse, error)
+	RemoveDefaultRolesFunc           func(ctx context.Context, body RemoveDefaultRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveDefaultRolesResponse, error)
+	GetSubjectsFunc                  func(ctx context.Context, params *GetSubjectsParams, reqEditors ...RequestEditorFn) (*GetSubjectsResponse, error)
+	GetUserMembershipsFunc           func(ctx context.Context, email string, params *GetUserMembershipsParams, reqEditors ...RequestEditorFn) (*GetUserMembershipsResponse, error)
+	GetUserPermissionsFunc           func(ctx context.Context, email string, params *GetUserPermissionsParams, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error)
+	GetUserResourcesFunc             func(ctx context.Context, email string, params *GetUserResourcesParams, reqEditors ...RequestEditorFn) (*GetUserResourcesResponse, error)
+	ValidateChildMembersWithBodyFunc func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateChildMembersResponse, error)
+	ValidateChildMembersFunc         func(ctx context.Context, resourceID string, body ValidateChildMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateChildMembersResponse, error)
+	AddMembersWithBodyFunc           func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMembersResponse, error)
+	AddMembersFunc                   func(ctx context.Context, resourceID string, body AddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMembersResponse, error)
+	ReplaceMembersWithBodyFunc       func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceMembersResponse, error)
+	ReplaceMembersFunc               func(ctx context.Context, resourceID string, body ReplaceMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceMembersResponse, error)
+	RemoveMembersWithBodyFunc        func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveMembersResponse, error)
+	RemoveMembersFunc                func(ctx context.Context, resourceID string, body RemoveMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveMembersResponse, error)
+	ValidateMembersWithBodyFunc      func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateMembersResponse, error)
+	ValidateMembersFunc              func(ctx context.Context, resourceID string, body ValidateMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateMembersResponse, error)
+	AddRolesWithBodyFunc             func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRolesResponse, error)
+	AddRolesFunc                     func(ctx context.Context, resourceID string, body AddRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRolesResponse, error)
+	RemoveRolesWithBodyFunc          func(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveRolesResponse, error)
+	RemoveRolesFunc                  func(ctx context.Context, resourceID string, body RemoveRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveRolesResponse, error)
+	GetMembersFunc                   func(ctx context.Context, resourceType string, resourceID string, params *GetMembersParams, reqEditors ...RequestEditorFn) (*GetMembersResponse, error)
+	GetRolesFunc                     func(ctx context.Context, resourceType string, resourceID string, reqEditors ...RequestEditorFn) (*GetRolesResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// GetPermissions calls GetPermissionsFunc
+func (f *Fake) GetPermissions(ctx context.Context, params *GetPermissionsParams, reqEditors ...RequestEditorFn) (*GetPermissionsResponse, error) {
+	if f.GetPermissionsFunc == nil {
+		return nil, notFaked("GetPermissions")
+	}
+	return f.GetPermissionsFunc(ctx, params, reqEditors...)
+}
+
+// AddPermissionsWithBody calls AddPermissionsWithBodyFunc
+func (f *Fake) AddPermissionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPermissionsResponse, error) {
+	if f.AddPermissionsWithBodyFunc == nil {
+		return nil, notFaked("AddPermissionsWithBody")
+	}
+	return f.AddPermissionsWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// AddPermissions calls AddPermissionsFunc
+func (f *Fake) AddPermissions(ctx context.Context, body AddPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPermissionsResponse, error) {
+	if f.AddPermissionsFunc == nil {
+		return nil, notFaked("AddPermissions")
+	}
+	return f.AddPermissionsFunc(ctx, body, reqEditors...)
+}
+
+// RemovePermissionsWithBody calls RemovePermissionsWithBodyFunc
+func (f *Fake) RemovePermissionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemovePermissionsResponse, error) {
+	if f.RemovePermissionsWithBodyFunc == nil {
+		return nil, notFaked("RemovePermissionsWithBody")
+	}
+	return f.RemovePermissionsWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// RemovePermissions calls RemovePermissionsFunc
+func (f *Fake) RemovePermissions(ctx context.Context, body RemovePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*RemovePermissionsResponse, error) {
+	if f.RemovePermissionsFunc == nil {
+		return nil, notFaked("RemovePermissions")
+	}
+	return f.RemovePermissionsFunc(ctx, body, reqEditors...)
+}
+
+// GetDefaultRoles calls GetDefaultRolesFunc
+func (f *Fake) GetDefaultRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDefaultRolesResponse, error) {
+	if f.GetDefaultRolesFunc == nil {
+		return nil, notFaked("GetDefaultRoles")
+	}
+	return f.GetDefaultRolesFunc(ctx, reqEditors...)
+}
+
+// AddDefaultRolesWithBody calls AddDefaultRolesWithBodyFunc
+func (f *Fake) AddDefaultRolesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDefaultRolesResponse, error) {
+	if f.AddDefaultRolesWithBodyFunc == nil {
+		return nil, notFaked("AddDefaultRolesWithBody")
+	}
+	return f.AddDefaultRolesWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// AddDefaultRoles calls AddDefaultRolesFunc
+func (f *Fake) AddDefaultRoles(ctx context.Context, body AddDefaultRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDefaultRolesResponse, error) {
+	if f.AddDefaultRolesFunc == nil {
+		return nil, notFaked("AddDefaultRoles")
+	}
+	return f.AddDefaultRolesFunc(ctx, body, reqEditors...)
+}
+
+// RemoveDefaultRolesWithBody calls RemoveDefaultRolesWithBodyFunc
+func (f *Fake) RemoveDefaultRolesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveDefaultRolesResponse, error) {
+	if f.RemoveDefaultRolesWithBodyFunc == nil {
+		return nil, notFaked("RemoveDefaultRolesWithBody")
+	}
+	return f.RemoveDefaultRolesWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// RemoveDefaultRoles calls RemoveDefaultRolesFunc
+func (f *Fake) RemoveDefaultRoles(ctx context.Context, body RemoveDefaultRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveDefaultRolesResponse, error) {
+	if f.RemoveDefaultRolesFunc == nil {
+		return nil, notFaked("RemoveDefaultRoles")
+	}
+	return f.RemoveDefaultRolesFunc(ctx, body, reqEditors...)
+}
+
+// GetSubjects calls GetSubjectsFunc
+func (f *Fake) GetSubjects(ctx context.Context, params *GetSubjectsParams, reqEditors ...RequestEditorFn) (*GetSubjectsResponse, error) {
+	if f.GetSubjectsFunc == nil {
+		return nil, notFaked("GetSubjects")
+	}
+	return f.GetSubjectsFunc(ctx, params, reqEditors...)
+}
+
+// GetUserMemberships calls GetUserMembershipsFunc
+func (f *Fake) GetUserMemberships(ctx context.Context, email string, params *GetUserMembershipsParams, reqEditors ...RequestEditorFn) (*GetUserMembershipsResponse, error) {
+	if f.GetUserMembershipsFunc == nil {
+		return nil, notFaked("GetUserMemberships")
+	}
+	return f.GetUserMembershipsFunc(ctx, email, params, reqEditors...)
+}
+
+// GetUserPermissions calls GetUserPermissionsFunc
+func (f *Fake) GetUserPermissions(ctx context.Context, email string, params *GetUserPermissionsParams, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error) {
+	if f.GetUserPermissionsFunc == nil {
+		return nil, notFaked("GetUserPermissions")
+	}
+	return f.GetUserPermissionsFunc(ctx, email, params, reqEditors...)
+}
+
+// GetUserResources calls GetUserResourcesFunc
+func (f *Fake) GetUserResources(ctx context.Context, email string, params *GetUserResourcesParams, reqEditors ...RequestEditorFn) (*GetUserResourcesResponse, error) {
+	if f.GetUserResourcesFunc == nil {
+		return nil, notFaked("GetUserResources")
+	}
+	return f.GetUserResourcesFunc(ctx, email, params, reqEditors...)
+}
+
+// ValidateChildMembersWithBody calls ValidateChildMembersWithBodyFunc
+func (f *Fake) ValidateChildMembersWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateChildMembersResponse, error) {
+	if f.ValidateChildMembersWithBodyFunc == nil {
+		return nil, notFaked("ValidateChildMembersWithBody")
+	}
+	return f.ValidateChildMembersWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// ValidateChildMembers calls ValidateChildMembersFunc
+func (f *Fake) ValidateChildMembers(ctx context.Context, resourceID string, body ValidateChildMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateChildMembersResponse, error) {
+	if f.ValidateChildMembersFunc == nil {
+		return nil, notFaked("ValidateChildMembers")
+	}
+	return f.ValidateChildMembersFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// AddMembersWithBody calls AddMembersWithBodyFunc
+func (f *Fake) AddMembersWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMembersResponse, error) {
+	if f.AddMembersWithBodyFunc == nil {
+		return nil, notFaked("AddMembersWithBody")
+	}
+	return f.AddMembersWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// AddMembers calls AddMembersFunc
+func (f *Fake) AddMembers(ctx context.Context, resourceID string, body AddMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMembersResponse, error) {
+	if f.AddMembersFunc == nil {
+		return nil, notFaked("AddMembers")
+	}
+	return f.AddMembersFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// ReplaceMembersWithBody calls ReplaceMembersWithBodyFunc
+func (f *Fake) ReplaceMembersWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceMembersResponse, error) {
+	if f.ReplaceMembersWithBodyFunc == nil {
+		return nil, notFaked("ReplaceMembersWithBody")
+	}
+	return f.ReplaceMembersWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// ReplaceMembers calls ReplaceMembersFunc
+func (f *Fake) ReplaceMembers(ctx context.Context, resourceID string, body ReplaceMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceMembersResponse, error) {
+	if f.ReplaceMembersFunc == nil {
+		return nil, notFaked("ReplaceMembers")
+	}
+	return f.ReplaceMembersFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// RemoveMembersWithBody calls RemoveMembersWithBodyFunc
+func (f *Fake) RemoveMembersWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveMembersResponse, error) {
+	if f.RemoveMembersWithBodyFunc == nil {
+		return nil, notFaked("RemoveMembersWithBody")
+	}
+	return f.RemoveMembersWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// RemoveMembers calls RemoveMembersFunc
+func (f *Fake) RemoveMembers(ctx context.Context, resourceID string, body RemoveMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveMembersResponse, error) {
+	if f.RemoveMembersFunc == nil {
+		return nil, notFaked("RemoveMembers")
+	}
+	return f.RemoveMembersFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// ValidateMembersWithBody calls ValidateMembersWithBodyFunc
+func (f *Fake) ValidateMembersWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateMembersResponse, error) {
+	if f.ValidateMembersWithBodyFunc == nil {
+		return nil, notFaked("ValidateMembersWithBody")
+	}
+	return f.ValidateMembersWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// ValidateMembers calls ValidateMembersFunc
+func (f *Fake) ValidateMembers(ctx context.Context, resourceID string, body ValidateMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateMembersResponse, error) {
+	if f.ValidateMembersFunc == nil {
+		return nil, notFaked("ValidateMembers")
+	}
+	return f.ValidateMembersFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// AddRolesWithBody calls AddRolesWithBodyFunc
+func (f *Fake) AddRolesWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRolesResponse, error) {
+	if f.AddRolesWithBodyFunc == nil {
+		return nil, notFaked("AddRolesWithBody")
+	}
+	return f.AddRolesWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// AddRoles calls AddRolesFunc
+func (f *Fake) AddRoles(ctx context.Context, resourceID string, body AddRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRolesResponse, error) {
+	if f.AddRolesFunc == nil {
+		return nil, notFaked("AddRoles")
+	}
+	return f.AddRolesFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// RemoveRolesWithBody calls RemoveRolesWithBodyFunc
+func (f *Fake) RemoveRolesWithBody(ctx context.Context, resourceID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveRolesResponse, error) {
+	if f.RemoveRolesWithBodyFunc == nil {
+		return nil, notFaked("RemoveRolesWithBody")
+	}
+	return f.RemoveRolesWithBodyFunc(ctx, resourceID, contentType, body, reqEditors...)
+}
+
+// RemoveRoles calls RemoveRolesFunc
+func (f *Fake) RemoveRoles(ctx context.Context, resourceID string, body RemoveRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveRolesResponse, error) {
+	if f.RemoveRolesFunc == nil {
+		return nil, notFaked("RemoveRoles")
+	}
+	return f.RemoveRolesFunc(ctx, resourceID, body, reqEditors...)
+}
+
+// GetMembers calls GetMembersFunc
+func (f *Fake) GetMembers(ctx context.Context, resourceType string, resourceID string, params *GetMembersParams, reqEditors ...RequestEditorFn) (*GetMembersResponse, error) {
+	if f.GetMembersFunc == nil {
+		return nil, notFaked("GetMembers")
+	}
+	return f.GetMembersFunc(ctx, resourceType, resourceID, params, reqEditors...)
+}
+
+// GetRoles calls GetRolesFunc
+func (f *Fake) GetRoles(ctx context.Context, resourceType string, resourceID string, reqEditors ...RequestEditorFn) (*GetRolesResponse, error) {
+	if f.GetRolesFunc == nil {
+		return nil, notFaked("GetRoles")
+	}
+	return f.GetRolesFunc(ctx, resourceType, resourceID, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
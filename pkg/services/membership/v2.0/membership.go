@@ -259,8 +259,8 @@ type GetSubjectsParams struct {
 // GetUserMembershipsParams defines parameters for GetUserMemberships.
 type GetUserMembershipsParams struct {
 	ResourceType     *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
-	ResourceID       *string `form:"resourceID,omitempty" json:"resourceID,omitempty"`
-	ParentResourceID *string `form:"parentResourceID,omitempty" json:"parentResourceID,omitempty"`
+	ResourceID       *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+	ParentResourceID *string `form:"parentResourceId,omitempty" json:"parentResourceId,omitempty"`
 }
 
 // GetUserPermissionsParams defines parameters for GetUserPermissions.
@@ -273,7 +273,7 @@ type GetUserPermissionsParams struct {
 
 // GetUserResourcesParams defines parameters for GetUserResources.
 type GetUserResourcesParams struct {
-	ParentResourceID   *string   `form:"parentResourceID,omitempty" json:"parentResourceID,omitempty"`
+	ParentResourceID   *string   `form:"parentResourceId,omitempty" json:"parentResourceId,omitempty"`
 	ParentResourceType *string   `form:"parentResourceType,omitempty" json:"parentResourceType,omitempty"`
 	ResourceType       *string   `form:"resourceType,omitempty" json:"resourceType,omitempty"`
 	Recursive          *bool     `form:"recursive,omitempty" json:"recursive,omitempty"`
@@ -1115,7 +1115,7 @@ func NewGetUserMembershipsRequest(ctx context.Context, server string, email stri
 
 	if params.ResourceID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceID", runtime.ParamLocationQuery, *params.ResourceID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, *params.ResourceID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1131,7 +1131,7 @@ func NewGetUserMembershipsRequest(ctx context.Context, server string, email stri
 
 	if params.ParentResourceID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentResourceID", runtime.ParamLocationQuery, *params.ParentResourceID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentResourceId", runtime.ParamLocationQuery, *params.ParentResourceID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1287,7 +1287,7 @@ func NewGetUserResourcesRequest(ctx context.Context, server string, email string
 
 	if params.ParentResourceID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentResourceID", runtime.ParamLocationQuery, *params.ParentResourceID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentResourceId", runtime.ParamLocationQuery, *params.ParentResourceID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1424,7 +1424,7 @@ func NewValidateChildMembersRequestWithBody(ctx context.Context, server string,
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1471,7 +1471,7 @@ func NewAddMembersRequestWithBody(ctx context.Context, server string, resourceID
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1518,7 +1518,7 @@ func NewReplaceMembersRequestWithBody(ctx context.Context, server string, resour
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1565,7 +1565,7 @@ func NewRemoveMembersRequestWithBody(ctx context.Context, server string, resourc
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1612,7 +1612,7 @@ func NewValidateMembersRequestWithBody(ctx context.Context, server string, resou
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1659,7 +1659,7 @@ func NewAddRolesRequestWithBody(ctx context.Context, server string, resourceID s
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1706,7 +1706,7 @@ func NewRemoveRolesRequestWithBody(ctx context.Context, server string, resourceI
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1749,7 +1749,7 @@ func NewGetMembersRequest(ctx context.Context, server string, resourceType strin
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -1810,7 +1810,7 @@ func NewGetRolesRequest(ctx context.Context, server string, resourceType string,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceID", runtime.ParamLocationPath, resourceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceID)
 	if err != nil {
 		return nil, err
 	}
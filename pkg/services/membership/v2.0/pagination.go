@@ -0,0 +1,31 @@
+package membership
+
+import (
+	"context"
+	"iter"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// GetUserResourcesIter returns an iterator over all resources of a user matching params
+func GetUserResourcesIter(ctx context.Context, c ClientWithResponsesInterface, email string, params *GetUserResourcesParams) iter.Seq2[Resource, error] {
+	p := GetUserResourcesParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]Resource, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetUserResources(ctx, email, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		if len(res.JSON200.Items) < int(res.JSON200.Limit) {
+			return res.JSON200.Items, "", nil
+		}
+		return res.JSON200.Items, res.JSON200.Cursor, nil
+	})
+}
@@ -17,5 +17,5 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	return NewClient(BaseURLs.Get(), c)
+	return NewClient(BaseURLs.Get(), contracts.WithUserAgent(c, "membership", "v2.0"))
 }
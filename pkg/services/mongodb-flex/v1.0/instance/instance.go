@@ -73,7 +73,7 @@ type InstanceFlavor struct {
 type InstanceListInstance struct {
 	ID     *string `json:"id,omitempty"`
 	Name   *string `json:"name,omitempty"`
-	Status *string `json:"status,omitempty"`
+	Status *Status `json:"status,omitempty"`
 }
 
 // InstanceListInstanceResponse defines model for instance.ListInstanceResponse.
@@ -136,7 +136,7 @@ type InstancesSingleInstance struct {
 	Name           *string            `json:"name,omitempty"`
 	Options        *map[string]string `json:"options,omitempty"`
 	Replicas       *int               `json:"replicas,omitempty"`
-	Status         *string            `json:"status,omitempty"`
+	Status         *Status            `json:"status,omitempty"`
 	Storage        *InstanceStorage   `json:"storage,omitempty"`
 	Version        *string            `json:"version,omitempty"`
 }
@@ -0,0 +1,46 @@
+package bucket
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists creates the bucket if it doesn't exist and waits until it's available
+// it returns the bucket name and whether the bucket was created
+func EnsureExists(ctx context.Context, c ClientWithResponsesInterface, projectID, bucketName string) (string, bool, error) {
+	res, err := c.Get(ctx, projectID, bucketName)
+	if err = validate.Response(res, err); err == nil {
+		return bucketName, false, nil
+	}
+	if !validate.StatusEquals(res, http.StatusNotFound) {
+		return "", false, err
+	}
+
+	created, err := c.Create(ctx, projectID, bucketName)
+	if err = validate.Response(created, err); err != nil {
+		return "", false, err
+	}
+	_, err = created.WaitHandler(ctx, c, projectID, bucketName).Wait(ctx)
+	return bucketName, true, err
+}
+
+// EnsureDeleted deletes the bucket if it exists and waits for the deletion
+// the returned bool reports whether the bucket was deleted
+func EnsureDeleted(ctx context.Context, c ClientWithResponsesInterface, projectID, bucketName string) (bool, error) {
+	res, err := c.Get(ctx, projectID, bucketName)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted, err := c.Delete(ctx, projectID, bucketName)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, bucketName).Wait(ctx)
+	return true, err
+}
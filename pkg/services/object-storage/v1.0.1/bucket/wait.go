@@ -2,48 +2,57 @@ package bucket
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
-// Wait waits for creation. in case there are no errors, the returned interface is of *GetResponse
-func (*CreateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, bucketName string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "bucket_create"
+	kindDelete = "bucket_delete"
+)
+
+// Wait waits for creation. in case there are no errors, the returned value is the bucket's *GetResponse
+func (*CreateResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, bucketName string) *wait.Handler[*GetResponse] {
+	return wait.NewHandler(func() (*GetResponse, bool, error) {
 		res, err := c.Get(ctx, projectID, bucketName)
 		if err = validate.Response(res, err); err != nil {
-			if validate.StatusEquals(res,
-				http.StatusBadGateway,
-				http.StatusGatewayTimeout,
-				http.StatusInternalServerError,
-				http.StatusNotFound,
-			) {
+			// the bucket isn't visible yet
+			if validate.StatusEquals(res, http.StatusNotFound) {
 				return nil, false, nil
 			}
-			return nil, false, err
+			return nil, false, wait.NewRequestError(res, err)
 		}
 		return res, true, nil
-	})
+	}).Describe("object-storage", kindCreate, "created", map[string]string{"projectID": projectID, "bucketName": bucketName})
 }
 
 // Wait waits for deletion
-func (*DeleteResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, bucketName string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value is always empty
+func (*DeleteResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, bucketName string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		res, err := c.Get(ctx, projectID, bucketName)
 		if err = validate.Response(res, err); err != nil {
 			if validate.StatusEquals(res, http.StatusNotFound) {
-				return nil, true, nil
+				return struct{}{}, true, nil
 			}
-			if validate.StatusEquals(res,
-				http.StatusBadGateway,
-				http.StatusGatewayTimeout,
-				http.StatusInternalServerError,
-			) {
-				return nil, false, nil
-			}
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(res, err)
 		}
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("object-storage", kindDelete, "deleted", map[string]string{"projectID": projectID, "bucketName": bucketName})
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["bucketName"])).Resume(op)
+	case kindDelete:
+		return wait.Any((*DeleteResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["bucketName"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
 }
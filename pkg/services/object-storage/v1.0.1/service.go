@@ -3,6 +3,10 @@ package objectstorage
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	accesskey "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/access-key"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/bucket"
+	credentialsgroup "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/credentials-group"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/project"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,10 +14,27 @@ var BaseURLs = baseurl.New(
 	"https://object-storage.api.eu01.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	AccessKey        accesskey.ClientWithResponsesInterface
+	Bucket           bucket.ClientWithResponsesInterface
+	CredentialsGroup credentialsgroup.ClientWithResponsesInterface
+	Project          project.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
 	nc, _ := NewClient(
 		BaseURLs.Get(),
-		WithHTTPClient(c),
+		WithHTTPClient(contracts.WithUserAgent(c, "object-storage", "v1.0.1")),
 	)
-	return nc
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		AccessKey:        nc.AccessKey,
+		Bucket:           nc.Bucket,
+		CredentialsGroup: nc.CredentialsGroup,
+		Project:          nc.Project,
+	}
 }
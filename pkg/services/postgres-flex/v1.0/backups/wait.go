@@ -0,0 +1,53 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// kind of the operation described by the wait handler
+const kindUpdate = "backups_update"
+
+// WaitHandler will wait for the backup schedule update to be applied to the instance
+// backupSchedule is the schedule that was set in the request body
+// returned value is the last observed *instance.InstanceSingleInstance
+func (*UpdateResponse) WaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, projectID, instanceID, backupSchedule string) *wait.Handler[*instance.InstanceSingleInstance] {
+	return wait.NewHandler(func() (res *instance.InstanceSingleInstance, done bool, err error) {
+		s, err := c.Get(ctx, projectID, instanceID)
+		if err = validate.Response(s, err, "JSON200.Item.Status"); err != nil {
+			return nil, false, wait.NewRequestError(s, err)
+		}
+		item := s.JSON200.Item
+		if *item.Status == instance.STATUS_FAILED {
+			return item, false, &wait.TerminalError{State: string(*item.Status)}
+		}
+		if *item.Status == instance.STATUS_READY && item.BackupSchedule != nil && *item.BackupSchedule == backupSchedule {
+			return item, true, nil
+		}
+		return item, false, nil
+	}).SetStateFunc(instanceState).SetInitialDelay(5*time.Second).
+		Describe("postgres-flex", kindUpdate, string(instance.STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID, "backupSchedule": backupSchedule})
+}
+
+// instanceState returns the status of an observed instance
+func instanceState(res *instance.InstanceSingleInstance) string {
+	if res == nil || res.Status == nil {
+		return ""
+	}
+	return string(*res.Status)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c instance.ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindUpdate:
+		return wait.Any((*UpdateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"], op.IDs["backupSchedule"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
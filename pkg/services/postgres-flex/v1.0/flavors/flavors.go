@@ -94,7 +94,7 @@ func NewListRequest(ctx context.Context, server string, projectID string) (*http
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,61 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// EnsureExists creates the instance if no instance with body.Name exists and waits until it's ready
+// an existing instance isn't updated, the returned bool reports whether the instance was created
+func EnsureExists(ctx context.Context, c ClientWithResponsesInterface, projectID string, body CreateJSONRequestBody) (instanceID string, created bool, err error) {
+	if body.Name == nil {
+		return "", false, errors.New("instance name must be set")
+	}
+	list, err := c.List(ctx, projectID)
+	if err = validate.Response(list, err, "JSON200"); err != nil {
+		return "", false, err
+	}
+	if list.JSON200.Items != nil {
+		for _, i := range *list.JSON200.Items {
+			if i.ID == nil || i.Name == nil || *i.Name != *body.Name {
+				continue
+			}
+			_, err = (&CreateResponse{}).WaitHandler(ctx, c, projectID, *i.ID).SetInitialDelay(0).Wait(ctx)
+			return *i.ID, false, err
+		}
+	}
+
+	res, err := c.Create(ctx, projectID, body)
+	if err = validate.Response(res, err, "JSON201.ID"); err != nil {
+		return "", false, err
+	}
+	instanceID = *res.JSON201.ID
+	_, err = res.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return instanceID, true, err
+}
+
+// EnsureDeleted deletes the instance if it exists and waits for the deletion
+// a soft-deleted instance doesn't exist anymore
+// the returned bool reports whether the instance was deleted
+func EnsureDeleted(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) (bool, error) {
+	res, err := c.Get(ctx, projectID, instanceID)
+	if err = validate.Response(res, err); err != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if instanceDeleted(res) {
+		return false, nil
+	}
+
+	deleted, err := c.Delete(ctx, projectID, instanceID)
+	if err = validate.Response(deleted, err); err != nil {
+		return false, err
+	}
+	_, err = deleted.WaitHandler(ctx, c, projectID, instanceID).Wait(ctx)
+	return true, err
+}
@@ -0,0 +1,39 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package instance
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of Status
+func (Status) Values() []Status {
+	return []Status{
+		STATUS_READY,
+		STATUS_FAILED,
+		STATUS_PROCESSING,
+		STATUS_DELETED,
+	}
+}
+
+// IsValid reports whether e is one of the values of Status
+func (e Status) IsValid() bool {
+	switch e {
+	case STATUS_READY, STATUS_FAILED, STATUS_PROCESSING, STATUS_DELETED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e Status) String() string {
+	return string(e)
+}
+
+// ParseStatus returns s as Status, or a *validate.EnumError if s isn't one of its values
+func ParseStatus(s string) (Status, error) {
+	return validate.ParseEnum[Status](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *Status) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
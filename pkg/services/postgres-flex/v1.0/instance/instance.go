@@ -86,7 +86,7 @@ type InstanceGetInstanceResponse struct {
 type InstanceListInstance struct {
 	ID     *string `json:"id,omitempty"`
 	Name   *string `json:"name,omitempty"`
-	Status *string `json:"status,omitempty"`
+	Status *Status `json:"status,omitempty"`
 }
 
 // InstanceListInstanceResponse defines model for instance.ListInstanceResponse.
@@ -105,7 +105,7 @@ type InstanceSingleInstance struct {
 	Name           *string            `json:"name,omitempty"`
 	Options        *map[string]string `json:"options,omitempty"`
 	Replicas       *int               `json:"replicas,omitempty"`
-	Status         *string            `json:"status,omitempty"`
+	Status         *Status            `json:"status,omitempty"`
 	Storage        *InstanceStorage   `json:"storage,omitempty"`
 	Version        *string            `json:"version,omitempty"`
 }
@@ -344,7 +344,7 @@ func NewListRequest(ctx context.Context, server string, projectID string) (*http
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -389,7 +389,7 @@ func NewCreateRequestWithBody(ctx context.Context, server string, projectID stri
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -425,14 +425,14 @@ func NewDeleteRequest(ctx context.Context, server string, projectID string, inst
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceID", runtime.ParamLocationPath, instanceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceId", runtime.ParamLocationPath, instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -466,14 +466,14 @@ func NewGetRequest(ctx context.Context, server string, projectID string, instanc
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceID", runtime.ParamLocationPath, instanceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceId", runtime.ParamLocationPath, instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -518,14 +518,14 @@ func NewPatchRequestWithBody(ctx context.Context, server string, projectID strin
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceID", runtime.ParamLocationPath, instanceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceId", runtime.ParamLocationPath, instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -572,14 +572,14 @@ func NewPutRequestWithBody(ctx context.Context, server string, projectID string,
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceID", runtime.ParamLocationPath, instanceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceId", runtime.ParamLocationPath, instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -626,14 +626,14 @@ func NewCreateCloneRequestWithBody(ctx context.Context, server string, projectID
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceID", runtime.ParamLocationPath, instanceID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "instanceId", runtime.ParamLocationPath, instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,7 @@ package instance
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -11,59 +11,116 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
 )
 
+// kinds of the operations described by the wait handlers
+const (
+	kindCreate = "instance_create"
+	kindPut    = "instance_put"
+	kindPatch  = "instance_patch"
+	kindClone  = "instance_clone"
+	kindDelete = "instance_delete"
+)
+
 // Wait will wait for instance create to complete
-func (*CreateResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *InstanceSingleInstance
+func (*CreateResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindCreate, string(STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for instance update to complete
-func (*PutResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *InstanceSingleInstance
+func (*PutResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindPut, string(STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
 // Wait will wait for instance update to complete
-func (*PatchResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return waitForCreateOrUpdate(ctx, c, projectID, instanceID)
+// returned value is the last observed *InstanceSingleInstance
+func (*PatchResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindPatch, string(STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
 }
 
-// returned interface is of *InstanceSingleInstance
-func waitForCreateOrUpdate(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
+// Wait will wait for the cloned instance to be ready
+// instanceID is the ID of the new instance, returned in JSON201.InstanceID
+// returned value is the last observed *InstanceSingleInstance
+func (*CreateCloneResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*InstanceSingleInstance] {
+	return waitForCreateOrUpdate(ctx, c, projectID, instanceID).
+		Describe("postgres-flex", kindClone, string(STATUS_READY), map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// returned value is the last observed *InstanceSingleInstance
+func waitForCreateOrUpdate(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[*InstanceSingleInstance] {
 	// artifical wait for instance to change from status ready to updating
-	time.Sleep(5 * time.Second)
-	return wait.New(func() (res interface{}, done bool, err error) {
+	return wait.NewHandler(func() (res *InstanceSingleInstance, done bool, err error) {
 		s, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(s, err, "JSON200.Item"); err != nil {
-			return nil, false, err
+			return nil, false, wait.NewRequestError(s, err)
 		}
 		if *s.JSON200.Item.Status == STATUS_READY {
 			return s.JSON200.Item, true, nil
 		}
 		if *s.JSON200.Item.Status == STATUS_FAILED {
-			return s.JSON200.Item, false, errors.New("received status FAILED from server")
+			return s.JSON200.Item, false, &wait.TerminalError{State: string(*s.JSON200.Item.Status)}
 		}
 		return s.JSON200.Item, false, nil
-	})
+	}).SetStateFunc(instanceState).SetInitialDelay(5 * time.Second)
 }
 
 // Wait will wait for instance deletion
-// returned value for deletion wait will always be nil
-func (DeleteResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, instanceID string) *wait.Handler {
-	return wait.New(func() (interface{}, bool, error) {
+// returned value for deletion wait will always be empty
+func (DeleteResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, instanceID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (struct{}, bool, error) {
 		res, err := c.Get(ctx, projectID, instanceID)
 		if err = validate.Response(res, err); err != nil {
 			// old handling of not found
-			if res != nil && res.StatusCode() == http.StatusNotFound {
-				return nil, true, nil
+			if validate.StatusEquals(res, http.StatusNotFound) {
+				return struct{}{}, true, nil
 			}
 
-			return nil, false, err
+			return struct{}{}, false, wait.NewRequestError(res, err)
 		}
 
 		// new soft-deletion
-		if res.JSON200 != nil && res.JSON200.Item.Status != nil && strings.ToUpper(*res.JSON200.Item.Status) == "DELETED" {
-			return nil, true, nil
+		if instanceDeleted(res) {
+			return struct{}{}, true, nil
 		}
 
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("postgres-flex", kindDelete, "deleted", map[string]string{"projectID": projectID, "instanceID": instanceID})
+}
+
+// instanceState returns the status of an observed instance
+func instanceState(res *InstanceSingleInstance) string {
+	if res == nil || res.Status == nil {
+		return ""
+	}
+	return string(*res.Status)
+}
+
+// ResumeWaitHandler recreates the wait handler of a stored operation, see wait.Operation
+// the returned handler continues the wait with the operation's remaining timeout
+func ResumeWaitHandler(ctx context.Context, c ClientWithResponsesInterface, op wait.Operation) (*wait.Handler[interface{}], error) {
+	switch op.Kind {
+	case kindCreate:
+		return wait.Any((*CreateResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPut:
+		return wait.Any((*PutResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindPatch:
+		return wait.Any((*PatchResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindClone:
+		return wait.Any((*CreateCloneResponse)(nil).WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	case kindDelete:
+		return wait.Any(DeleteResponse{}.WaitHandler(ctx, c, op.IDs["projectID"], op.IDs["instanceID"])).Resume(op)
+	}
+	return nil, fmt.Errorf("%w: %s %s", wait.ErrUnknownOperation, op.Service, op.Kind)
+}
+
+// instanceDeleted reports whether the instance was soft-deleted
+// the status is compared case-insensitively
+func instanceDeleted(res *GetResponse) bool {
+	if res == nil || res.JSON200 == nil || res.JSON200.Item == nil || res.JSON200.Item.Status == nil {
+		return false
+	}
+	return strings.EqualFold(string(*res.JSON200.Item.Status), string(STATUS_DELETED))
 }
@@ -0,0 +1,34 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/watch"
+)
+
+// NewWatcher returns a watcher for the instances of a project
+// instances are keyed by ID, every listed instance is fetched to observe spec changes
+func NewWatcher(c ClientWithResponsesInterface, projectID string) *watch.Watcher[InstanceSingleInstance] {
+	return watch.New(func(ctx context.Context) (map[string]InstanceSingleInstance, error) {
+		list, err := c.List(ctx, projectID)
+		if err = validate.Response(list, err, "JSON200"); err != nil {
+			return nil, err
+		}
+		items := map[string]InstanceSingleInstance{}
+		if list.JSON200.Items == nil {
+			return items, nil
+		}
+		for _, i := range *list.JSON200.Items {
+			if i.ID == nil {
+				continue
+			}
+			resp, err := c.Get(ctx, projectID, *i.ID)
+			if err = validate.Response(resp, err, "JSON200.Item"); err != nil {
+				return nil, err
+			}
+			items[*i.ID] = *resp.JSON200.Item
+		}
+		return items, nil
+	})
+}
@@ -3,6 +3,12 @@ package postgresflex
 import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/backups"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/flavors"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/storage"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/users"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/versions"
 )
 
 var BaseURLs = baseurl.New(
@@ -10,10 +16,31 @@ var BaseURLs = baseurl.New(
 	"https://postgres-flex-service.api.eu01.stackit.cloud",
 )
 
-func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
+// Service exposes the clients of the service resources as interfaces
+// so they can be replaced in tests, i.e. by the Fake of each resource package
+type Service struct {
+	Backups  backups.ClientWithResponsesInterface
+	Users    users.ClientWithResponsesInterface
+	Storage  storage.ClientWithResponsesInterface
+	Versions versions.ClientWithResponsesInterface
+	Flavors  flavors.ClientWithResponsesInterface
+	Instance instance.ClientWithResponsesInterface
+}
+
+func NewService(c contracts.BaseClientInterface) *Service {
 	nc, _ := NewClient(
 		BaseURLs.Get(),
-		WithHTTPClient(c),
+		WithHTTPClient(contracts.WithUserAgent(c, "postgres-flex", "v1.0")),
 	)
-	return nc
+	if nc == nil {
+		return nil
+	}
+	return &Service{
+		Backups:  nc.Backups,
+		Users:    nc.Users,
+		Storage:  nc.Storage,
+		Versions: nc.Versions,
+		Flavors:  nc.Flavors,
+		Instance: nc.Instance,
+	}
 }
@@ -93,14 +93,14 @@ func NewGetStorageOptionsRequest(ctx context.Context, server string, projectID s
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "flavorID", runtime.ParamLocationPath, flavorID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "flavorId", runtime.ParamLocationPath, flavorID)
 	if err != nil {
 		return nil, err
 	}
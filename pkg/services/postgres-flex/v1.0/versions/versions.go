@@ -39,7 +39,7 @@ type InstanceGetVersionsResponse struct {
 // ListParams defines parameters for List.
 type ListParams struct {
 	// InstanceID Instance ID
-	InstanceID *string `form:"instanceID,omitempty" json:"instanceID,omitempty"`
+	InstanceID *string `form:"instanceId,omitempty" json:"instanceId,omitempty"`
 }
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
@@ -92,7 +92,7 @@ func NewListRequest(ctx context.Context, server string, projectID string, params
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectID", runtime.ParamLocationPath, projectID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +116,7 @@ func NewListRequest(ctx context.Context, server string, projectID string, params
 
 	if params.InstanceID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "instanceID", runtime.ParamLocationQuery, *params.InstanceID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "instanceId", runtime.ParamLocationQuery, *params.InstanceID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -0,0 +1,326 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package resourcemanagement
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of ChildrenResponseItemsType
+func (ChildrenResponseItemsType) Values() []ChildrenResponseItemsType {
+	return []ChildrenResponseItemsType{
+		CHILDREN_RESPONSE_ITEMS_TYPE_PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of ChildrenResponseItemsType
+func (e ChildrenResponseItemsType) IsValid() bool {
+	switch e {
+	case CHILDREN_RESPONSE_ITEMS_TYPE_PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ChildrenResponseItemsType) String() string {
+	return string(e)
+}
+
+// ParseChildrenResponseItemsType returns s as ChildrenResponseItemsType, or a *validate.EnumError if s isn't one of its values
+func ParseChildrenResponseItemsType(s string) (ChildrenResponseItemsType, error) {
+	return validate.ParseEnum[ChildrenResponseItemsType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ChildrenResponseItemsType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ContainerResponseItemsType
+func (ContainerResponseItemsType) Values() []ContainerResponseItemsType {
+	return []ContainerResponseItemsType{
+		CONTAINER_RESPONSE_ITEMS_TYPE_PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of ContainerResponseItemsType
+func (e ContainerResponseItemsType) IsValid() bool {
+	switch e {
+	case CONTAINER_RESPONSE_ITEMS_TYPE_PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ContainerResponseItemsType) String() string {
+	return string(e)
+}
+
+// ParseContainerResponseItemsType returns s as ContainerResponseItemsType, or a *validate.EnumError if s isn't one of its values
+func ParseContainerResponseItemsType(s string) (ContainerResponseItemsType, error) {
+	return validate.ParseEnum[ContainerResponseItemsType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ContainerResponseItemsType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetContainersOfAFolderParamsType
+func (GetContainersOfAFolderParamsType) Values() []GetContainersOfAFolderParamsType {
+	return []GetContainersOfAFolderParamsType{
+		GET_CONTAINERS_OF_A_FOLDER_PARAMS_TYPE_PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetContainersOfAFolderParamsType
+func (e GetContainersOfAFolderParamsType) IsValid() bool {
+	switch e {
+	case GET_CONTAINERS_OF_A_FOLDER_PARAMS_TYPE_PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetContainersOfAFolderParamsType) String() string {
+	return string(e)
+}
+
+// ParseGetContainersOfAFolderParamsType returns s as GetContainersOfAFolderParamsType, or a *validate.EnumError if s isn't one of its values
+func ParseGetContainersOfAFolderParamsType(s string) (GetContainersOfAFolderParamsType, error) {
+	return validate.ParseEnum[GetContainersOfAFolderParamsType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetContainersOfAFolderParamsType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetContainersOfAnOrganizationParamsType
+func (GetContainersOfAnOrganizationParamsType) Values() []GetContainersOfAnOrganizationParamsType {
+	return []GetContainersOfAnOrganizationParamsType{
+		PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetContainersOfAnOrganizationParamsType
+func (e GetContainersOfAnOrganizationParamsType) IsValid() bool {
+	switch e {
+	case PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetContainersOfAnOrganizationParamsType) String() string {
+	return string(e)
+}
+
+// ParseGetContainersOfAnOrganizationParamsType returns s as GetContainersOfAnOrganizationParamsType, or a *validate.EnumError if s isn't one of its values
+func ParseGetContainersOfAnOrganizationParamsType(s string) (GetContainersOfAnOrganizationParamsType, error) {
+	return validate.ParseEnum[GetContainersOfAnOrganizationParamsType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetContainersOfAnOrganizationParamsType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of GetOrganizationsContainerIDSupportParamsType
+func (GetOrganizationsContainerIDSupportParamsType) Values() []GetOrganizationsContainerIDSupportParamsType {
+	return []GetOrganizationsContainerIDSupportParamsType{
+		GET_ORGANIZATIONS_CONTAINER_ID_SUPPORT_PARAMS_TYPE_PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of GetOrganizationsContainerIDSupportParamsType
+func (e GetOrganizationsContainerIDSupportParamsType) IsValid() bool {
+	switch e {
+	case GET_ORGANIZATIONS_CONTAINER_ID_SUPPORT_PARAMS_TYPE_PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GetOrganizationsContainerIDSupportParamsType) String() string {
+	return string(e)
+}
+
+// ParseGetOrganizationsContainerIDSupportParamsType returns s as GetOrganizationsContainerIDSupportParamsType, or a *validate.EnumError if s isn't one of its values
+func ParseGetOrganizationsContainerIDSupportParamsType(s string) (GetOrganizationsContainerIDSupportParamsType, error) {
+	return validate.ParseEnum[GetOrganizationsContainerIDSupportParamsType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GetOrganizationsContainerIDSupportParamsType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of LifecycleState
+func (LifecycleState) Values() []LifecycleState {
+	return []LifecycleState{
+		ACTIVE,
+		CREATING,
+		DELETING,
+		INACTIVE,
+	}
+}
+
+// IsValid reports whether e is one of the values of LifecycleState
+func (e LifecycleState) IsValid() bool {
+	switch e {
+	case ACTIVE, CREATING, DELETING, INACTIVE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e LifecycleState) String() string {
+	return string(e)
+}
+
+// ParseLifecycleState returns s as LifecycleState, or a *validate.EnumError if s isn't one of its values
+func ParseLifecycleState(s string) (LifecycleState, error) {
+	return validate.ParseEnum[LifecycleState](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *LifecycleState) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of OrganizationMemberRole
+func (OrganizationMemberRole) Values() []OrganizationMemberRole {
+	return []OrganizationMemberRole{
+		ORGANIZATION_ADMIN,
+		ORGANIZATION_AUDITOR,
+		ORGANIZATION_MEMBER,
+		ORGANIZATION_OWNER,
+	}
+}
+
+// IsValid reports whether e is one of the values of OrganizationMemberRole
+func (e OrganizationMemberRole) IsValid() bool {
+	switch e {
+	case ORGANIZATION_ADMIN, ORGANIZATION_AUDITOR, ORGANIZATION_MEMBER, ORGANIZATION_OWNER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e OrganizationMemberRole) String() string {
+	return string(e)
+}
+
+// ParseOrganizationMemberRole returns s as OrganizationMemberRole, or a *validate.EnumError if s isn't one of its values
+func ParseOrganizationMemberRole(s string) (OrganizationMemberRole, error) {
+	return validate.ParseEnum[OrganizationMemberRole](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *OrganizationMemberRole) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ParentListType
+func (ParentListType) Values() []ParentListType {
+	return []ParentListType{
+		PARENT_LIST_TYPE_FOLDER,
+		PARENT_LIST_TYPE_ORGANIZATION,
+	}
+}
+
+// IsValid reports whether e is one of the values of ParentListType
+func (e ParentListType) IsValid() bool {
+	switch e {
+	case PARENT_LIST_TYPE_FOLDER, PARENT_LIST_TYPE_ORGANIZATION:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ParentListType) String() string {
+	return string(e)
+}
+
+// ParseParentListType returns s as ParentListType, or a *validate.EnumError if s isn't one of its values
+func ParseParentListType(s string) (ParentListType, error) {
+	return validate.ParseEnum[ParentListType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ParentListType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ParentType
+func (ParentType) Values() []ParentType {
+	return []ParentType{
+		PARENT_TYPE_FOLDER,
+		PARENT_TYPE_ORGANIZATION,
+	}
+}
+
+// IsValid reports whether e is one of the values of ParentType
+func (e ParentType) IsValid() bool {
+	switch e {
+	case PARENT_TYPE_FOLDER, PARENT_TYPE_ORGANIZATION:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ParentType) String() string {
+	return string(e)
+}
+
+// ParseParentType returns s as ParentType, or a *validate.EnumError if s isn't one of its values
+func ParseParentType(s string) (ParentType, error) {
+	return validate.ParseEnum[ParentType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ParentType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectMemberRole
+func (ProjectMemberRole) Values() []ProjectMemberRole {
+	return []ProjectMemberRole{
+		PROJECT_ADMIN,
+		PROJECT_AUDITOR,
+		PROJECT_MEMBER,
+		PROJECT_OWNER,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectMemberRole
+func (e ProjectMemberRole) IsValid() bool {
+	switch e {
+	case PROJECT_ADMIN, PROJECT_AUDITOR, PROJECT_MEMBER, PROJECT_OWNER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectMemberRole) String() string {
+	return string(e)
+}
+
+// ParseProjectMemberRole returns s as ProjectMemberRole, or a *validate.EnumError if s isn't one of its values
+func ParseProjectMemberRole(s string) (ProjectMemberRole, error) {
+	return validate.ParseEnum[ProjectMemberRole](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectMemberRole) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
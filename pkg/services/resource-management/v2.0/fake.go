@@ -0,0 +1,264 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package resourcemanagement
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	GetFoldersFunc                            func(ctx context.Context, params *GetFoldersParams, reqEditors ...RequestEditorFn) (*GetFoldersResponse, error)
+	PostFoldersWithBodyFunc                   func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFoldersResponse, error)
+	PostFoldersFunc                           func(ctx context.Context, body PostFoldersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFoldersResponse, error)
+	DeleteFoldersContainerIDFunc              func(ctx context.Context, containerId string, params *DeleteFoldersContainerIDParams, reqEditors ...RequestEditorFn) (*DeleteFoldersContainerIDResponse, error)
+	GetFoldersContainerIDFunc                 func(ctx context.Context, containerId string, params *GetFoldersContainerIDParams, reqEditors ...RequestEditorFn) (*GetFoldersContainerIDResponse, error)
+	PatchFoldersContainerIDWithBodyFunc       func(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFoldersContainerIDResponse, error)
+	PatchFoldersContainerIDFunc               func(ctx context.Context, containerId string, body PatchFoldersContainerIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFoldersContainerIDResponse, error)
+	GetContainersOfAFolderFunc                func(ctx context.Context, containerId string, params *GetContainersOfAFolderParams, reqEditors ...RequestEditorFn) (*GetContainersOfAFolderResponse, error)
+	DeleteFolderLabelsFunc                    func(ctx context.Context, containerId string, params *DeleteFolderLabelsParams, reqEditors ...RequestEditorFn) (*DeleteFolderLabelsResponse, error)
+	GetAllOrganizationsFunc                   func(ctx context.Context, params *GetAllOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAllOrganizationsResponse, error)
+	PostOrganizationsWithBodyFunc             func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrganizationsResponse, error)
+	PostOrganizationsFunc                     func(ctx context.Context, body PostOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrganizationsResponse, error)
+	DeleteOrganizationsContainerIDFunc        func(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationsContainerIDResponse, error)
+	GetOrganizationsContainerIDFunc           func(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*GetOrganizationsContainerIDResponse, error)
+	PatchOrganizationsContainerIDWithBodyFunc func(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrganizationsContainerIDResponse, error)
+	PatchOrganizationsContainerIDFunc         func(ctx context.Context, containerId string, body PatchOrganizationsContainerIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrganizationsContainerIDResponse, error)
+	GetOrganizationsContainerIDSupportFunc    func(ctx context.Context, containerId string, params *GetOrganizationsContainerIDSupportParams, reqEditors ...RequestEditorFn) (*GetOrganizationsContainerIDSupportResponse, error)
+	GetContainersOfAnOrganizationFunc         func(ctx context.Context, containerId string, params *GetContainersOfAnOrganizationParams, reqEditors ...RequestEditorFn) (*GetContainersOfAnOrganizationResponse, error)
+	DeleteOrganizationsContainerIDLabelsFunc  func(ctx context.Context, containerId string, params *DeleteOrganizationsContainerIDLabelsParams, reqEditors ...RequestEditorFn) (*DeleteOrganizationsContainerIDLabelsResponse, error)
+	ListFunc                                  func(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error)
+	CreateWithBodyFunc                        func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	CreateFunc                                func(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	DeleteFunc                                func(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*DeleteResponse, error)
+	GetFunc                                   func(ctx context.Context, containerId string, params *GetParams, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	UpdateWithBodyFunc                        func(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	UpdateFunc                                func(ctx context.Context, containerId string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+	DeleteProjectContainerIDLabelsFunc        func(ctx context.Context, containerId string, params *DeleteProjectContainerIDLabelsParams, reqEditors ...RequestEditorFn) (*DeleteProjectContainerIDLabelsResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// GetFolders calls GetFoldersFunc
+func (f *Fake) GetFolders(ctx context.Context, params *GetFoldersParams, reqEditors ...RequestEditorFn) (*GetFoldersResponse, error) {
+	if f.GetFoldersFunc == nil {
+		return nil, notFaked("GetFolders")
+	}
+	return f.GetFoldersFunc(ctx, params, reqEditors...)
+}
+
+// PostFoldersWithBody calls PostFoldersWithBodyFunc
+func (f *Fake) PostFoldersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFoldersResponse, error) {
+	if f.PostFoldersWithBodyFunc == nil {
+		return nil, notFaked("PostFoldersWithBody")
+	}
+	return f.PostFoldersWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// PostFolders calls PostFoldersFunc
+func (f *Fake) PostFolders(ctx context.Context, body PostFoldersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFoldersResponse, error) {
+	if f.PostFoldersFunc == nil {
+		return nil, notFaked("PostFolders")
+	}
+	return f.PostFoldersFunc(ctx, body, reqEditors...)
+}
+
+// DeleteFoldersContainerID calls DeleteFoldersContainerIDFunc
+func (f *Fake) DeleteFoldersContainerID(ctx context.Context, containerId string, params *DeleteFoldersContainerIDParams, reqEditors ...RequestEditorFn) (*DeleteFoldersContainerIDResponse, error) {
+	if f.DeleteFoldersContainerIDFunc == nil {
+		return nil, notFaked("DeleteFoldersContainerID")
+	}
+	return f.DeleteFoldersContainerIDFunc(ctx, containerId, params, reqEditors...)
+}
+
+// GetFoldersContainerID calls GetFoldersContainerIDFunc
+func (f *Fake) GetFoldersContainerID(ctx context.Context, containerId string, params *GetFoldersContainerIDParams, reqEditors ...RequestEditorFn) (*GetFoldersContainerIDResponse, error) {
+	if f.GetFoldersContainerIDFunc == nil {
+		return nil, notFaked("GetFoldersContainerID")
+	}
+	return f.GetFoldersContainerIDFunc(ctx, containerId, params, reqEditors...)
+}
+
+// PatchFoldersContainerIDWithBody calls PatchFoldersContainerIDWithBodyFunc
+func (f *Fake) PatchFoldersContainerIDWithBody(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFoldersContainerIDResponse, error) {
+	if f.PatchFoldersContainerIDWithBodyFunc == nil {
+		return nil, notFaked("PatchFoldersContainerIDWithBody")
+	}
+	return f.PatchFoldersContainerIDWithBodyFunc(ctx, containerId, contentType, body, reqEditors...)
+}
+
+// PatchFoldersContainerID calls PatchFoldersContainerIDFunc
+func (f *Fake) PatchFoldersContainerID(ctx context.Context, containerId string, body PatchFoldersContainerIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFoldersContainerIDResponse, error) {
+	if f.PatchFoldersContainerIDFunc == nil {
+		return nil, notFaked("PatchFoldersContainerID")
+	}
+	return f.PatchFoldersContainerIDFunc(ctx, containerId, body, reqEditors...)
+}
+
+// GetContainersOfAFolder calls GetContainersOfAFolderFunc
+func (f *Fake) GetContainersOfAFolder(ctx context.Context, containerId string, params *GetContainersOfAFolderParams, reqEditors ...RequestEditorFn) (*GetContainersOfAFolderResponse, error) {
+	if f.GetContainersOfAFolderFunc == nil {
+		return nil, notFaked("GetContainersOfAFolder")
+	}
+	return f.GetContainersOfAFolderFunc(ctx, containerId, params, reqEditors...)
+}
+
+// DeleteFolderLabels calls DeleteFolderLabelsFunc
+func (f *Fake) DeleteFolderLabels(ctx context.Context, containerId string, params *DeleteFolderLabelsParams, reqEditors ...RequestEditorFn) (*DeleteFolderLabelsResponse, error) {
+	if f.DeleteFolderLabelsFunc == nil {
+		return nil, notFaked("DeleteFolderLabels")
+	}
+	return f.DeleteFolderLabelsFunc(ctx, containerId, params, reqEditors...)
+}
+
+// GetAllOrganizations calls GetAllOrganizationsFunc
+func (f *Fake) GetAllOrganizations(ctx context.Context, params *GetAllOrganizationsParams, reqEditors ...RequestEditorFn) (*GetAllOrganizationsResponse, error) {
+	if f.GetAllOrganizationsFunc == nil {
+		return nil, notFaked("GetAllOrganizations")
+	}
+	return f.GetAllOrganizationsFunc(ctx, params, reqEditors...)
+}
+
+// PostOrganizationsWithBody calls PostOrganizationsWithBodyFunc
+func (f *Fake) PostOrganizationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOrganizationsResponse, error) {
+	if f.PostOrganizationsWithBodyFunc == nil {
+		return nil, notFaked("PostOrganizationsWithBody")
+	}
+	return f.PostOrganizationsWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// PostOrganizations calls PostOrganizationsFunc
+func (f *Fake) PostOrganizations(ctx context.Context, body PostOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostOrganizationsResponse, error) {
+	if f.PostOrganizationsFunc == nil {
+		return nil, notFaked("PostOrganizations")
+	}
+	return f.PostOrganizationsFunc(ctx, body, reqEditors...)
+}
+
+// DeleteOrganizationsContainerID calls DeleteOrganizationsContainerIDFunc
+func (f *Fake) DeleteOrganizationsContainerID(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationsContainerIDResponse, error) {
+	if f.DeleteOrganizationsContainerIDFunc == nil {
+		return nil, notFaked("DeleteOrganizationsContainerID")
+	}
+	return f.DeleteOrganizationsContainerIDFunc(ctx, containerId, reqEditors...)
+}
+
+// GetOrganizationsContainerID calls GetOrganizationsContainerIDFunc
+func (f *Fake) GetOrganizationsContainerID(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*GetOrganizationsContainerIDResponse, error) {
+	if f.GetOrganizationsContainerIDFunc == nil {
+		return nil, notFaked("GetOrganizationsContainerID")
+	}
+	return f.GetOrganizationsContainerIDFunc(ctx, containerId, reqEditors...)
+}
+
+// PatchOrganizationsContainerIDWithBody calls PatchOrganizationsContainerIDWithBodyFunc
+func (f *Fake) PatchOrganizationsContainerIDWithBody(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrganizationsContainerIDResponse, error) {
+	if f.PatchOrganizationsContainerIDWithBodyFunc == nil {
+		return nil, notFaked("PatchOrganizationsContainerIDWithBody")
+	}
+	return f.PatchOrganizationsContainerIDWithBodyFunc(ctx, containerId, contentType, body, reqEditors...)
+}
+
+// PatchOrganizationsContainerID calls PatchOrganizationsContainerIDFunc
+func (f *Fake) PatchOrganizationsContainerID(ctx context.Context, containerId string, body PatchOrganizationsContainerIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrganizationsContainerIDResponse, error) {
+	if f.PatchOrganizationsContainerIDFunc == nil {
+		return nil, notFaked("PatchOrganizationsContainerID")
+	}
+	return f.PatchOrganizationsContainerIDFunc(ctx, containerId, body, reqEditors...)
+}
+
+// GetOrganizationsContainerIDSupport calls GetOrganizationsContainerIDSupportFunc
+func (f *Fake) GetOrganizationsContainerIDSupport(ctx context.Context, containerId string, params *GetOrganizationsContainerIDSupportParams, reqEditors ...RequestEditorFn) (*GetOrganizationsContainerIDSupportResponse, error) {
+	if f.GetOrganizationsContainerIDSupportFunc == nil {
+		return nil, notFaked("GetOrganizationsContainerIDSupport")
+	}
+	return f.GetOrganizationsContainerIDSupportFunc(ctx, containerId, params, reqEditors...)
+}
+
+// GetContainersOfAnOrganization calls GetContainersOfAnOrganizationFunc
+func (f *Fake) GetContainersOfAnOrganization(ctx context.Context, containerId string, params *GetContainersOfAnOrganizationParams, reqEditors ...RequestEditorFn) (*GetContainersOfAnOrganizationResponse, error) {
+	if f.GetContainersOfAnOrganizationFunc == nil {
+		return nil, notFaked("GetContainersOfAnOrganization")
+	}
+	return f.GetContainersOfAnOrganizationFunc(ctx, containerId, params, reqEditors...)
+}
+
+// DeleteOrganizationsContainerIDLabels calls DeleteOrganizationsContainerIDLabelsFunc
+func (f *Fake) DeleteOrganizationsContainerIDLabels(ctx context.Context, containerId string, params *DeleteOrganizationsContainerIDLabelsParams, reqEditors ...RequestEditorFn) (*DeleteOrganizationsContainerIDLabelsResponse, error) {
+	if f.DeleteOrganizationsContainerIDLabelsFunc == nil {
+		return nil, notFaked("DeleteOrganizationsContainerIDLabels")
+	}
+	return f.DeleteOrganizationsContainerIDLabelsFunc(ctx, containerId, params, reqEditors...)
+}
+
+// List calls ListFunc
+func (f *Fake) List(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error) {
+	if f.ListFunc == nil {
+		return nil, notFaked("List")
+	}
+	return f.ListFunc(ctx, params, reqEditors...)
+}
+
+// CreateWithBody calls CreateWithBodyFunc
+func (f *Fake) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateWithBodyFunc == nil {
+		return nil, notFaked("CreateWithBody")
+	}
+	return f.CreateWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// Create calls CreateFunc
+func (f *Fake) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateFunc == nil {
+		return nil, notFaked("Create")
+	}
+	return f.CreateFunc(ctx, body, reqEditors...)
+}
+
+// Delete calls DeleteFunc
+func (f *Fake) Delete(ctx context.Context, containerId string, reqEditors ...RequestEditorFn) (*DeleteResponse, error) {
+	if f.DeleteFunc == nil {
+		return nil, notFaked("Delete")
+	}
+	return f.DeleteFunc(ctx, containerId, reqEditors...)
+}
+
+// Get calls GetFunc
+func (f *Fake) Get(ctx context.Context, containerId string, params *GetParams, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	if f.GetFunc == nil {
+		return nil, notFaked("Get")
+	}
+	return f.GetFunc(ctx, containerId, params, reqEditors...)
+}
+
+// UpdateWithBody calls UpdateWithBodyFunc
+func (f *Fake) UpdateWithBody(ctx context.Context, containerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateWithBodyFunc == nil {
+		return nil, notFaked("UpdateWithBody")
+	}
+	return f.UpdateWithBodyFunc(ctx, containerId, contentType, body, reqEditors...)
+}
+
+// Update calls UpdateFunc
+func (f *Fake) Update(ctx context.Context, containerId string, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	if f.UpdateFunc == nil {
+		return nil, notFaked("Update")
+	}
+	return f.UpdateFunc(ctx, containerId, body, reqEditors...)
+}
+
+// DeleteProjectContainerIDLabels calls DeleteProjectContainerIDLabelsFunc
+func (f *Fake) DeleteProjectContainerIDLabels(ctx context.Context, containerId string, params *DeleteProjectContainerIDLabelsParams, reqEditors ...RequestEditorFn) (*DeleteProjectContainerIDLabelsResponse, error) {
+	if f.DeleteProjectContainerIDLabelsFunc == nil {
+		return nil, notFaked("DeleteProjectContainerIDLabels")
+	}
+	return f.DeleteProjectContainerIDLabelsFunc(ctx, containerId, params, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
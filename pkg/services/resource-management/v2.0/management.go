@@ -84,30 +84,7 @@ const (
 
 // AllFoldersResponse defines model for AllFoldersResponse.
 type AllFoldersResponse struct {
-	Items []struct {
-		// ContainerId Globally unique, user-friendly identifier. Will replace old, legacy identifier "folderId".
-		ContainerID string `json:"containerId"`
-
-		// CreationTime Timestamp at which the folder was created.
-		CreationTime string `json:"creationTime"`
-
-		// FolderId Globally unique, legacy folder identifier (for backward compatibility)
-		FolderID openapiTypes.UUID `json:"folderId"`
-
-		// Labels Labels are key-value string pairs which can be attached to a resource container. Some labels may be enforced via policies.
-		// - A label key must match the regex `[A-ZÄÜÖa-zäüöß0-9_-]{1,64}`.
-		// - A label value must match the regex `^$|[A-ZÄÜÖa-zäüöß0-9_-]{1,64}`.
-		Labels *ResourceLabels `json:"labels,omitempty"`
-
-		// Name Name of the folder.
-		Name string `json:"name"`
-
-		// Parent Parent container.
-		Parent Parent `json:"parent"`
-
-		// UpdateTime Timestamp at which the folder was created.
-		UpdateTime string `json:"updateTime"`
-	} `json:"items"`
+	Items []FolderItem `json:"items"`
 
 	// Limit The maximum number of projects to return in the response. If not present, an appropriate default will be used.
 	Limit LimitSchema `json:"limit"`
@@ -116,34 +93,35 @@ type AllFoldersResponse struct {
 	Offset OffsetSchema `json:"offset"`
 }
 
-// AllOrgResponse defines model for AllOrgResponse.
-type AllOrgResponse struct {
-	Items []struct {
-		// ContainerId Globally unique, user-friendly identifier. Will replace old, legacy identifier "organizationId".
-		ContainerID string `json:"containerId"`
+// FolderItem defines model for AllFoldersResponse.Items.
+type FolderItem struct {
+	// ContainerId Globally unique, user-friendly identifier. Will replace old, legacy identifier "folderId".
+	ContainerID string `json:"containerId"`
+
+	// CreationTime Timestamp at which the folder was created.
+	CreationTime string `json:"creationTime"`
+
+	// FolderId Globally unique, legacy folder identifier (for backward compatibility)
+	FolderID openapiTypes.UUID `json:"folderId"`
 
-		// CreationTime Timestamp at which the organization was created.
-		CreationTime string `json:"creationTime"`
+	// Labels Labels are key-value string pairs which can be attached to a resource container. Some labels may be enforced via policies.
+	// - A label key must match the regex `[A-ZÄÜÖa-zäüöß0-9_-]{1,64}`.
+	// - A label value must match the regex `^$|[A-ZÄÜÖa-zäüöß0-9_-]{1,64}`.
+	Labels *ResourceLabels `json:"labels,omitempty"`
 
-		// LifecycleState Lifecycle state of the resource container.
-		//
-		// | LIFECYCLE STATE | DESCRIPTION |
-		// |----------|--------------------|
-		// | CREATING | The creation process has been triggered. The state remains until resource manager gets notified about successful process completion. |
-		// | ACTIVE   | Resource container can be fully used. |
-		// | INACTIVE | Resource container usage has been disabled. |
-		// | DELETING | The deletion process has been triggered. The state remains until resource manager gets notified about successful process completion. Afterwards, the record will be deleted. |
-		LifecycleState LifecycleState `json:"lifecycleState"`
+	// Name Name of the folder.
+	Name string `json:"name"`
 
-		// Name Name of the organization.
-		Name string `json:"name"`
+	// Parent Parent container.
+	Parent Parent `json:"parent"`
 
-		// OrganizationId Globally unique, legacy organization identifier (for backward compatibility)
-		OrganizationID openapiTypes.UUID `json:"organizationId"`
+	// UpdateTime Timestamp at which the folder was created.
+	UpdateTime string `json:"updateTime"`
+}
 
-		// UpdateTime Timestamp at which the organization was last modified.
-		UpdateTime string `json:"updateTime"`
-	} `json:"items"`
+// AllOrgResponse defines model for AllOrgResponse.
+type AllOrgResponse struct {
+	Items []OrganizationItem `json:"items"`
 
 	// Limit The maximum number of projects to return in the response. If not present, an appropriate default will be used.
 	Limit LimitSchema `json:"limit"`
@@ -152,6 +130,34 @@ type AllOrgResponse struct {
 	Offset OffsetSchema `json:"offset"`
 }
 
+// OrganizationItem defines model for AllOrgResponse.Items.
+type OrganizationItem struct {
+	// ContainerId Globally unique, user-friendly identifier. Will replace old, legacy identifier "organizationId".
+	ContainerID string `json:"containerId"`
+
+	// CreationTime Timestamp at which the organization was created.
+	CreationTime string `json:"creationTime"`
+
+	// LifecycleState Lifecycle state of the resource container.
+	//
+	// | LIFECYCLE STATE | DESCRIPTION |
+	// |----------|--------------------|
+	// | CREATING | The creation process has been triggered. The state remains until resource manager gets notified about successful process completion. |
+	// | ACTIVE   | Resource container can be fully used. |
+	// | INACTIVE | Resource container usage has been disabled. |
+	// | DELETING | The deletion process has been triggered. The state remains until resource manager gets notified about successful process completion. Afterwards, the record will be deleted. |
+	LifecycleState LifecycleState `json:"lifecycleState"`
+
+	// Name Name of the organization.
+	Name string `json:"name"`
+
+	// OrganizationId Globally unique, legacy organization identifier (for backward compatibility)
+	OrganizationID openapiTypes.UUID `json:"organizationId"`
+
+	// UpdateTime Timestamp at which the organization was last modified.
+	UpdateTime string `json:"updateTime"`
+}
+
 // AllProjectsResponse defines model for AllProjectsResponse.
 type AllProjectsResponse struct {
 	Items []ProjectResponse `json:"items"`
@@ -165,12 +171,7 @@ type AllProjectsResponse struct {
 
 // ChildrenResponse defines model for ChildrenResponse.
 type ChildrenResponse struct {
-	Items []struct {
-		Item ProjectResponse `json:"item"`
-
-		// Type Resource container type.
-		Type ChildrenResponseItemsType `json:"type"`
-	} `json:"items"`
+	Items []ChildrenItem `json:"items"`
 
 	// Limit The maximum number of projects to return in the response. If not present, an appropriate default will be used.
 	Limit LimitSchema `json:"limit"`
@@ -179,24 +180,35 @@ type ChildrenResponse struct {
 	Offset OffsetSchema `json:"offset"`
 }
 
+// ChildrenItem defines model for ChildrenResponse.Items.
+type ChildrenItem struct {
+	Item ProjectResponse `json:"item"`
+
+	// Type Resource container type.
+	Type ChildrenResponseItemsType `json:"type"`
+}
+
 // ChildrenResponseItemsType Resource container type.
 type ChildrenResponseItemsType string
 
 // ContainerResponse defines model for ContainerResponse.
 type ContainerResponse struct {
 	// Cursor A pagination cursor is returned on the first call of the pagination process. If given, it will start from the end of the previous position. If not given, a new pagination is started.
-	Cursor CursorSchema `json:"cursor"`
-	Items  []struct {
-		Item ProjectResponse `json:"item"`
-
-		// Type Resource container type.
-		Type ContainerResponseItemsType `json:"type"`
-	} `json:"items"`
+	Cursor CursorSchema    `json:"cursor"`
+	Items  []ContainerItem `json:"items"`
 
 	// Limit The maximum number of projects to return in the response. If not present, an appropriate default will be used.
 	Limit LimitSchema `json:"limit"`
 }
 
+// ContainerItem defines model for ContainerResponse.Items.
+type ContainerItem struct {
+	Item ProjectResponse `json:"item"`
+
+	// Type Resource container type.
+	Type ContainerResponseItemsType `json:"type"`
+}
+
 // ContainerResponseItemsType Resource container type.
 type ContainerResponseItemsType string
 
@@ -552,10 +564,10 @@ type Recursive = bool
 // GetFoldersParams defines parameters for GetFolders.
 type GetFoldersParams struct {
 	// ContainerParentID container Id of the parent resource container.
-	ContainerParentID *string `form:"containerParentID,omitempty" json:"containerParentID,omitempty"`
+	ContainerParentID *string `form:"containerParentId,omitempty" json:"containerParentId,omitempty"`
 
 	// ContainerIDs List of user-friendly container Ids.
-	ContainerIDs *[]interface{} `form:"containerIDs,omitempty" json:"containerIDs,omitempty"`
+	ContainerIDs *[]interface{} `form:"containerIds,omitempty" json:"containerIds,omitempty"`
 
 	// Member E-Mail address of the user for whom the visible resource containers should be filtered.
 	Member *Member `form:"member,omitempty" json:"member,omitempty"`
@@ -608,7 +620,7 @@ type DeleteFolderLabelsParams struct {
 // GetAllOrganizationsParams defines parameters for GetAllOrganizations.
 type GetAllOrganizationsParams struct {
 	// ContainerIDs Preferable the containerIds, but for migration purpose, the legacy uuids of the organizations are accepted as well.
-	ContainerIDs *[]interface{} `form:"containerIDs,omitempty" json:"containerIDs,omitempty"`
+	ContainerIDs *[]interface{} `form:"containerIds,omitempty" json:"containerIds,omitempty"`
 
 	// Member E-Mail address of the user for whom the visible resource containers should be filtered.
 	Member *Member `form:"member,omitempty" json:"member,omitempty"`
@@ -671,10 +683,10 @@ type DeleteOrganizationsContainerIDLabelsParams struct {
 // ListParams defines parameters for List.
 type ListParams struct {
 	// ContainerParentID Container ID from parent container.
-	ContainerParentID *string `form:"containerParentID,omitempty" json:"containerParentID,omitempty"`
+	ContainerParentID *string `form:"containerParentId,omitempty" json:"containerParentId,omitempty"`
 
 	// ContainerIDs List of container IDs
-	ContainerIDs *[]interface{} `form:"containerIDs,omitempty" json:"containerIDs,omitempty"`
+	ContainerIDs *[]interface{} `form:"containerIds,omitempty" json:"containerIds,omitempty"`
 
 	// Member E-Mail address of the user for whom the visible resource containers should be filtered.
 	Member *Member `form:"member,omitempty" json:"member,omitempty"`
@@ -1203,7 +1215,7 @@ func NewGetFoldersRequest(ctx context.Context, server string, params *GetFolders
 
 	if params.ContainerParentID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerParentID", runtime.ParamLocationQuery, *params.ContainerParentID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerParentId", runtime.ParamLocationQuery, *params.ContainerParentID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1219,7 +1231,7 @@ func NewGetFoldersRequest(ctx context.Context, server string, params *GetFolders
 
 	if params.ContainerIDs != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIDs", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIds", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1681,7 +1693,7 @@ func NewGetAllOrganizationsRequest(ctx context.Context, server string, params *G
 
 	if params.ContainerIDs != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIDs", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIds", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2221,7 +2233,7 @@ func NewListRequest(ctx context.Context, server string, params *ListParams) (*ht
 
 	if params.ContainerParentID != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerParentID", runtime.ParamLocationQuery, *params.ContainerParentID); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerParentId", runtime.ParamLocationQuery, *params.ContainerParentID); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2237,7 +2249,7 @@ func NewListRequest(ctx context.Context, server string, params *ListParams) (*ht
 
 	if params.ContainerIDs != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIDs", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "containerIds", runtime.ParamLocationQuery, *params.ContainerIDs); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -0,0 +1,123 @@
+package resourcemanagement
+
+import (
+	"context"
+	"iter"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListIter returns an iterator over all projects matching params
+func ListIter(ctx context.Context, c ClientWithResponsesInterface, params *ListParams) iter.Seq2[ProjectResponse, error] {
+	p := ListParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]ProjectResponse, int, error) {
+		o := Offset(offset)
+		p.Offset = &o
+		res, err := c.List(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetFoldersIter returns an iterator over all folders matching params
+func GetFoldersIter(ctx context.Context, c ClientWithResponsesInterface, params *GetFoldersParams) iter.Seq2[FolderItem, error] {
+	p := GetFoldersParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]FolderItem, int, error) {
+		o := Offset(offset)
+		p.Offset = &o
+		res, err := c.GetFolders(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetAllOrganizationsIter returns an iterator over all organizations matching params
+func GetAllOrganizationsIter(ctx context.Context, c ClientWithResponsesInterface, params *GetAllOrganizationsParams) iter.Seq2[OrganizationItem, error] {
+	p := GetAllOrganizationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]OrganizationItem, int, error) {
+		o := Offset(offset)
+		p.Offset = &o
+		res, err := c.GetAllOrganizations(ctx, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetOrganizationsContainerIDSupportIter returns an iterator over all support containers of an organization
+func GetOrganizationsContainerIDSupportIter(ctx context.Context, c ClientWithResponsesInterface, containerID string, params *GetOrganizationsContainerIDSupportParams) iter.Seq2[ChildrenItem, error] {
+	p := GetOrganizationsContainerIDSupportParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Offset(func(offset int) ([]ChildrenItem, int, error) {
+		o := Offset(offset)
+		p.Offset = &o
+		res, err := c.GetOrganizationsContainerIDSupport(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Items, int(res.JSON200.Limit), nil
+	})
+}
+
+// GetContainersOfAFolderIter returns an iterator over all containers of a folder
+func GetContainersOfAFolderIter(ctx context.Context, c ClientWithResponsesInterface, containerID string, params *GetContainersOfAFolderParams) iter.Seq2[ContainerItem, error] {
+	p := GetContainersOfAFolderParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]ContainerItem, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetContainersOfAFolder(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		return res.JSON200.Items, nextCursor(res.JSON200), nil
+	})
+}
+
+// GetContainersOfAnOrganizationIter returns an iterator over all containers of an organization
+func GetContainersOfAnOrganizationIter(ctx context.Context, c ClientWithResponsesInterface, containerID string, params *GetContainersOfAnOrganizationParams) iter.Seq2[ContainerItem, error] {
+	p := GetContainersOfAnOrganizationParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Cursor(func(cursor string) ([]ContainerItem, string, error) {
+		p.Cursor = nil
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.GetContainersOfAnOrganization(ctx, containerID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, "", err
+		}
+		return res.JSON200.Items, nextCursor(res.JSON200), nil
+	})
+}
+
+// nextCursor returns the cursor of the next page or an empty string if the page is the last one
+func nextCursor(res *ContainerResponse) string {
+	if float32(len(res.Items)) < res.Limit {
+		return ""
+	}
+	return res.Cursor
+}
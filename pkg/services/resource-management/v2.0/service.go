@@ -13,7 +13,7 @@ var BaseURLs = baseurl.New(
 func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
 	nc, _ := NewClient(
 		BaseURLs.Get(),
-		WithHTTPClient(c),
+		WithHTTPClient(contracts.WithUserAgent(c, "resource-management", "v2.0")),
 	)
 	return nc
 }
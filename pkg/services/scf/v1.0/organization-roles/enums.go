@@ -0,0 +1,39 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package organizationroles
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of OrgRoleCreateRequestType
+func (OrgRoleCreateRequestType) Values() []OrgRoleCreateRequestType {
+	return []OrgRoleCreateRequestType{
+		ORGANIZATION_AUDITOR,
+		ORGANIZATION_BILLING_MANAGER,
+		ORGANIZATION_MANAGER,
+		ORGANIZATION_USER,
+	}
+}
+
+// IsValid reports whether e is one of the values of OrgRoleCreateRequestType
+func (e OrgRoleCreateRequestType) IsValid() bool {
+	switch e {
+	case ORGANIZATION_AUDITOR, ORGANIZATION_BILLING_MANAGER, ORGANIZATION_MANAGER, ORGANIZATION_USER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e OrgRoleCreateRequestType) String() string {
+	return string(e)
+}
+
+// ParseOrgRoleCreateRequestType returns s as OrgRoleCreateRequestType, or a *validate.EnumError if s isn't one of its values
+func ParseOrgRoleCreateRequestType(s string) (OrgRoleCreateRequestType, error) {
+	return validate.ParseEnum[OrgRoleCreateRequestType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *OrgRoleCreateRequestType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
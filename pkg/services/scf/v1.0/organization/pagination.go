@@ -0,0 +1,35 @@
+package organization
+
+import (
+	"context"
+	"iter"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListOrganizationsIter returns an iterator over all organizations of a project matching params
+func ListOrganizationsIter(ctx context.Context, c ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, params *ListOrganizationsParams) iter.Seq2[OrganizationsListItem, error] {
+	p := ListOrganizationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Page(func(page int) ([]OrganizationsListItem, int, error) {
+		n := int64(page)
+		p.Page = &n
+		res, err := c.ListOrganizations(ctx, projectID, region, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Resources, totalPages(res.JSON200.Pagination), nil
+	})
+}
+
+// totalPages returns the total number of pages or 0 if unknown
+func totalPages(p Pagination) int {
+	if p.TotalPages == nil {
+		return 0
+	}
+	return int(*p.TotalPages)
+}
@@ -0,0 +1,39 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package spaceroles
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of SpaceRoleCreateRequestType
+func (SpaceRoleCreateRequestType) Values() []SpaceRoleCreateRequestType {
+	return []SpaceRoleCreateRequestType{
+		SPACE_AUDITOR,
+		SPACE_DEVELOPER,
+		SPACE_MANAGER,
+		SPACE_SUPPORTER,
+	}
+}
+
+// IsValid reports whether e is one of the values of SpaceRoleCreateRequestType
+func (e SpaceRoleCreateRequestType) IsValid() bool {
+	switch e {
+	case SPACE_AUDITOR, SPACE_DEVELOPER, SPACE_MANAGER, SPACE_SUPPORTER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e SpaceRoleCreateRequestType) String() string {
+	return string(e)
+}
+
+// ParseSpaceRoleCreateRequestType returns s as SpaceRoleCreateRequestType, or a *validate.EnumError if s isn't one of its values
+func ParseSpaceRoleCreateRequestType(s string) (SpaceRoleCreateRequestType, error) {
+	return validate.ParseEnum[SpaceRoleCreateRequestType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *SpaceRoleCreateRequestType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
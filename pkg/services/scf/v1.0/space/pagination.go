@@ -0,0 +1,35 @@
+package space
+
+import (
+	"context"
+	"iter"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListSpacesIter returns an iterator over all spaces of an organization matching params
+func ListSpacesIter(ctx context.Context, c ClientWithResponsesInterface, projectID openapiTypes.UUID, region string, organizationID openapiTypes.UUID, params *ListSpacesParams) iter.Seq2[Space, error] {
+	p := ListSpacesParams{}
+	if params != nil {
+		p = *params
+	}
+	return pagination.Page(func(page int) ([]Space, int, error) {
+		n := int64(page)
+		p.Page = &n
+		res, err := c.ListSpaces(ctx, projectID, region, organizationID, &p)
+		if err = validate.Response(res, err, "JSON200"); err != nil {
+			return nil, 0, err
+		}
+		return res.JSON200.Resources, totalPages(res.JSON200.Pagination), nil
+	})
+}
+
+// totalPages returns the total number of pages or 0 if unknown
+func totalPages(p Pagination) int {
+	if p.TotalPages == nil {
+		return 0
+	}
+	return int(*p.TotalPages)
+}
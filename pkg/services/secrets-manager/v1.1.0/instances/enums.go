@@ -0,0 +1,38 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package instances
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of State
+func (State) Values() []State {
+	return []State{
+		STATE_ACTIVE,
+		STATE_CREATING,
+		STATE_FAILED,
+	}
+}
+
+// IsValid reports whether e is one of the values of State
+func (e State) IsValid() bool {
+	switch e {
+	case STATE_ACTIVE, STATE_CREATING, STATE_FAILED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e State) String() string {
+	return string(e)
+}
+
+// ParseState returns s as State, or a *validate.EnumError if s isn't one of its values
+func ParseState(s string) (State, error) {
+	return validate.ParseEnum[State](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *State) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
@@ -0,0 +1,11 @@
+package instances
+
+// State is the state of an instance
+type State string
+
+// Instance state options
+const (
+	STATE_ACTIVE   State = "active"
+	STATE_CREATING State = "creating"
+	STATE_FAILED   State = "failed"
+)
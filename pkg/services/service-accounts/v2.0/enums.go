@@ -0,0 +1,479 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package serviceaccounts
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of GeKeyParamsFormat
+func (GeKeyParamsFormat) Values() []GeKeyParamsFormat {
+	return []GeKeyParamsFormat{
+		X509_PEM,
+	}
+}
+
+// IsValid reports whether e is one of the values of GeKeyParamsFormat
+func (e GeKeyParamsFormat) IsValid() bool {
+	switch e {
+	case X509_PEM:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e GeKeyParamsFormat) String() string {
+	return string(e)
+}
+
+// ParseGeKeyParamsFormat returns s as GeKeyParamsFormat, or a *validate.EnumError if s isn't one of its values
+func ParseGeKeyParamsFormat(s string) (GeKeyParamsFormat, error) {
+	return validate.ParseEnum[GeKeyParamsFormat](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *GeKeyParamsFormat) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyListResponseKeyAlgorithm
+func (ServiceAccountKeyListResponseKeyAlgorithm) Values() []ServiceAccountKeyListResponseKeyAlgorithm {
+	return []ServiceAccountKeyListResponseKeyAlgorithm{
+		SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ALGORITHM_RSA_2048,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyListResponseKeyAlgorithm
+func (e ServiceAccountKeyListResponseKeyAlgorithm) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ALGORITHM_RSA_2048:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyListResponseKeyAlgorithm) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyListResponseKeyAlgorithm returns s as ServiceAccountKeyListResponseKeyAlgorithm, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyListResponseKeyAlgorithm(s string) (ServiceAccountKeyListResponseKeyAlgorithm, error) {
+	return validate.ParseEnum[ServiceAccountKeyListResponseKeyAlgorithm](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyListResponseKeyAlgorithm) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyListResponseKeyOrigin
+func (ServiceAccountKeyListResponseKeyOrigin) Values() []ServiceAccountKeyListResponseKeyOrigin {
+	return []ServiceAccountKeyListResponseKeyOrigin{
+		SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ORIGIN_GENERATED,
+		SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ORIGIN_USER_PROVIDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyListResponseKeyOrigin
+func (e ServiceAccountKeyListResponseKeyOrigin) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ORIGIN_GENERATED, SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_ORIGIN_USER_PROVIDED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyListResponseKeyOrigin) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyListResponseKeyOrigin returns s as ServiceAccountKeyListResponseKeyOrigin, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyListResponseKeyOrigin(s string) (ServiceAccountKeyListResponseKeyOrigin, error) {
+	return validate.ParseEnum[ServiceAccountKeyListResponseKeyOrigin](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyListResponseKeyOrigin) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyListResponseKeyType
+func (ServiceAccountKeyListResponseKeyType) Values() []ServiceAccountKeyListResponseKeyType {
+	return []ServiceAccountKeyListResponseKeyType{
+		SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_TYPE_SYSTEM_MANAGED,
+		SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_TYPE_USER_MANAGED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyListResponseKeyType
+func (e ServiceAccountKeyListResponseKeyType) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_TYPE_SYSTEM_MANAGED, SERVICE_ACCOUNT_KEY_LIST_RESPONSE_KEY_TYPE_USER_MANAGED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyListResponseKeyType) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyListResponseKeyType returns s as ServiceAccountKeyListResponseKeyType, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyListResponseKeyType(s string) (ServiceAccountKeyListResponseKeyType, error) {
+	return validate.ParseEnum[ServiceAccountKeyListResponseKeyType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyListResponseKeyType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPatchResponseKeyAlgorithm
+func (ServiceAccountKeyPatchResponseKeyAlgorithm) Values() []ServiceAccountKeyPatchResponseKeyAlgorithm {
+	return []ServiceAccountKeyPatchResponseKeyAlgorithm{
+		SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ALGORITHM_RSA_2048,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPatchResponseKeyAlgorithm
+func (e ServiceAccountKeyPatchResponseKeyAlgorithm) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ALGORITHM_RSA_2048:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPatchResponseKeyAlgorithm) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPatchResponseKeyAlgorithm returns s as ServiceAccountKeyPatchResponseKeyAlgorithm, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPatchResponseKeyAlgorithm(s string) (ServiceAccountKeyPatchResponseKeyAlgorithm, error) {
+	return validate.ParseEnum[ServiceAccountKeyPatchResponseKeyAlgorithm](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPatchResponseKeyAlgorithm) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPatchResponseKeyOrigin
+func (ServiceAccountKeyPatchResponseKeyOrigin) Values() []ServiceAccountKeyPatchResponseKeyOrigin {
+	return []ServiceAccountKeyPatchResponseKeyOrigin{
+		SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ORIGIN_GENERATED,
+		SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ORIGIN_USER_PROVIDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPatchResponseKeyOrigin
+func (e ServiceAccountKeyPatchResponseKeyOrigin) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ORIGIN_GENERATED, SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_ORIGIN_USER_PROVIDED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPatchResponseKeyOrigin) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPatchResponseKeyOrigin returns s as ServiceAccountKeyPatchResponseKeyOrigin, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPatchResponseKeyOrigin(s string) (ServiceAccountKeyPatchResponseKeyOrigin, error) {
+	return validate.ParseEnum[ServiceAccountKeyPatchResponseKeyOrigin](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPatchResponseKeyOrigin) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPatchResponseKeyType
+func (ServiceAccountKeyPatchResponseKeyType) Values() []ServiceAccountKeyPatchResponseKeyType {
+	return []ServiceAccountKeyPatchResponseKeyType{
+		SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_TYPE_SYSTEM_MANAGED,
+		SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_TYPE_USER_MANAGED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPatchResponseKeyType
+func (e ServiceAccountKeyPatchResponseKeyType) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_TYPE_SYSTEM_MANAGED, SERVICE_ACCOUNT_KEY_PATCH_RESPONSE_KEY_TYPE_USER_MANAGED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPatchResponseKeyType) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPatchResponseKeyType returns s as ServiceAccountKeyPatchResponseKeyType, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPatchResponseKeyType(s string) (ServiceAccountKeyPatchResponseKeyType, error) {
+	return validate.ParseEnum[ServiceAccountKeyPatchResponseKeyType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPatchResponseKeyType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPrivateResponseKeyAlgorithm
+func (ServiceAccountKeyPrivateResponseKeyAlgorithm) Values() []ServiceAccountKeyPrivateResponseKeyAlgorithm {
+	return []ServiceAccountKeyPrivateResponseKeyAlgorithm{
+		SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ALGORITHM_RSA_2048,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPrivateResponseKeyAlgorithm
+func (e ServiceAccountKeyPrivateResponseKeyAlgorithm) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ALGORITHM_RSA_2048:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPrivateResponseKeyAlgorithm) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPrivateResponseKeyAlgorithm returns s as ServiceAccountKeyPrivateResponseKeyAlgorithm, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPrivateResponseKeyAlgorithm(s string) (ServiceAccountKeyPrivateResponseKeyAlgorithm, error) {
+	return validate.ParseEnum[ServiceAccountKeyPrivateResponseKeyAlgorithm](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPrivateResponseKeyAlgorithm) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPrivateResponseKeyOrigin
+func (ServiceAccountKeyPrivateResponseKeyOrigin) Values() []ServiceAccountKeyPrivateResponseKeyOrigin {
+	return []ServiceAccountKeyPrivateResponseKeyOrigin{
+		SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ORIGIN_GENERATED,
+		SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ORIGIN_USER_PROVIDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPrivateResponseKeyOrigin
+func (e ServiceAccountKeyPrivateResponseKeyOrigin) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ORIGIN_GENERATED, SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_ORIGIN_USER_PROVIDED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPrivateResponseKeyOrigin) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPrivateResponseKeyOrigin returns s as ServiceAccountKeyPrivateResponseKeyOrigin, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPrivateResponseKeyOrigin(s string) (ServiceAccountKeyPrivateResponseKeyOrigin, error) {
+	return validate.ParseEnum[ServiceAccountKeyPrivateResponseKeyOrigin](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPrivateResponseKeyOrigin) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyPrivateResponseKeyType
+func (ServiceAccountKeyPrivateResponseKeyType) Values() []ServiceAccountKeyPrivateResponseKeyType {
+	return []ServiceAccountKeyPrivateResponseKeyType{
+		SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_TYPE_SYSTEM_MANAGED,
+		SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_TYPE_USER_MANAGED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyPrivateResponseKeyType
+func (e ServiceAccountKeyPrivateResponseKeyType) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_TYPE_SYSTEM_MANAGED, SERVICE_ACCOUNT_KEY_PRIVATE_RESPONSE_KEY_TYPE_USER_MANAGED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyPrivateResponseKeyType) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyPrivateResponseKeyType returns s as ServiceAccountKeyPrivateResponseKeyType, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyPrivateResponseKeyType(s string) (ServiceAccountKeyPrivateResponseKeyType, error) {
+	return validate.ParseEnum[ServiceAccountKeyPrivateResponseKeyType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyPrivateResponseKeyType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyResponseWithKeyKeyAlgorithm
+func (ServiceAccountKeyResponseWithKeyKeyAlgorithm) Values() []ServiceAccountKeyResponseWithKeyKeyAlgorithm {
+	return []ServiceAccountKeyResponseWithKeyKeyAlgorithm{
+		SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ALGORITHM_RSA_2048,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyResponseWithKeyKeyAlgorithm
+func (e ServiceAccountKeyResponseWithKeyKeyAlgorithm) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ALGORITHM_RSA_2048:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyResponseWithKeyKeyAlgorithm) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyResponseWithKeyKeyAlgorithm returns s as ServiceAccountKeyResponseWithKeyKeyAlgorithm, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyResponseWithKeyKeyAlgorithm(s string) (ServiceAccountKeyResponseWithKeyKeyAlgorithm, error) {
+	return validate.ParseEnum[ServiceAccountKeyResponseWithKeyKeyAlgorithm](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyResponseWithKeyKeyAlgorithm) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyResponseWithKeyKeyOrigin
+func (ServiceAccountKeyResponseWithKeyKeyOrigin) Values() []ServiceAccountKeyResponseWithKeyKeyOrigin {
+	return []ServiceAccountKeyResponseWithKeyKeyOrigin{
+		SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ORIGIN_GENERATED,
+		SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ORIGIN_USER_PROVIDED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyResponseWithKeyKeyOrigin
+func (e ServiceAccountKeyResponseWithKeyKeyOrigin) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ORIGIN_GENERATED, SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_ORIGIN_USER_PROVIDED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyResponseWithKeyKeyOrigin) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyResponseWithKeyKeyOrigin returns s as ServiceAccountKeyResponseWithKeyKeyOrigin, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyResponseWithKeyKeyOrigin(s string) (ServiceAccountKeyResponseWithKeyKeyOrigin, error) {
+	return validate.ParseEnum[ServiceAccountKeyResponseWithKeyKeyOrigin](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyResponseWithKeyKeyOrigin) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ServiceAccountKeyResponseWithKeyKeyType
+func (ServiceAccountKeyResponseWithKeyKeyType) Values() []ServiceAccountKeyResponseWithKeyKeyType {
+	return []ServiceAccountKeyResponseWithKeyKeyType{
+		SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_TYPE_SYSTEM_MANAGED,
+		SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_TYPE_USER_MANAGED,
+	}
+}
+
+// IsValid reports whether e is one of the values of ServiceAccountKeyResponseWithKeyKeyType
+func (e ServiceAccountKeyResponseWithKeyKeyType) IsValid() bool {
+	switch e {
+	case SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_TYPE_SYSTEM_MANAGED, SERVICE_ACCOUNT_KEY_RESPONSE_WITH_KEY_KEY_TYPE_USER_MANAGED:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ServiceAccountKeyResponseWithKeyKeyType) String() string {
+	return string(e)
+}
+
+// ParseServiceAccountKeyResponseWithKeyKeyType returns s as ServiceAccountKeyResponseWithKeyKeyType, or a *validate.EnumError if s isn't one of its values
+func ParseServiceAccountKeyResponseWithKeyKeyType(s string) (ServiceAccountKeyResponseWithKeyKeyType, error) {
+	return validate.ParseEnum[ServiceAccountKeyResponseWithKeyKeyType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ServiceAccountKeyResponseWithKeyKeyType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of TokenRequestBodyGrantType
+func (TokenRequestBodyGrantType) Values() []TokenRequestBodyGrantType {
+	return []TokenRequestBodyGrantType{
+		REFRESH_TOKEN,
+		URN_IETF_PARAMS_OAUTH_GRANT_TYPE_JWT_BEARER,
+	}
+}
+
+// IsValid reports whether e is one of the values of TokenRequestBodyGrantType
+func (e TokenRequestBodyGrantType) IsValid() bool {
+	switch e {
+	case REFRESH_TOKEN, URN_IETF_PARAMS_OAUTH_GRANT_TYPE_JWT_BEARER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e TokenRequestBodyGrantType) String() string {
+	return string(e)
+}
+
+// ParseTokenRequestBodyGrantType returns s as TokenRequestBodyGrantType, or a *validate.EnumError if s isn't one of its values
+func ParseTokenRequestBodyGrantType(s string) (TokenRequestBodyGrantType, error) {
+	return validate.ParseEnum[TokenRequestBodyGrantType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *TokenRequestBodyGrantType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of TokenResponseBodyTokenType
+func (TokenResponseBodyTokenType) Values() []TokenResponseBodyTokenType {
+	return []TokenResponseBodyTokenType{
+		BEARER,
+	}
+}
+
+// IsValid reports whether e is one of the values of TokenResponseBodyTokenType
+func (e TokenResponseBodyTokenType) IsValid() bool {
+	switch e {
+	case BEARER:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e TokenResponseBodyTokenType) String() string {
+	return string(e)
+}
+
+// ParseTokenResponseBodyTokenType returns s as TokenResponseBodyTokenType, or a *validate.EnumError if s isn't one of its values
+func ParseTokenResponseBodyTokenType(s string) (TokenResponseBodyTokenType, error) {
+	return validate.ParseEnum[TokenResponseBodyTokenType](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *TokenResponseBodyTokenType) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
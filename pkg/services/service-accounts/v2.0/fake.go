@@ -0,0 +1,184 @@
+// Code generated by internal/tools/fakes. DO NOT EDIT.
+
+package serviceaccounts
+
+import (
+	"context"
+	"fmt"
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"io"
+)
+
+// Fake is a fake ClientWithResponsesInterface for tests
+// each method calls the field with the same name and the Func suffix, i.e. GetFunc for Get
+// and returns an error if the field isn't set
+type Fake struct {
+	CreateTokenWithBodyFunc         func(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+	CreateTokenWithFormdataBodyFunc func(ctx context.Context, body CreateTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+	GetFunc                         func(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	CreateWithBodyFunc              func(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	CreateFunc                      func(ctx context.Context, projectId string, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	DeleteFunc                      func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*DeleteResponse, error)
+	GetAccessTokensFunc             func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error)
+	CreateAccessTokensWithBodyFunc  func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokensResponse, error)
+	CreateAccessTokensFunc          func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, body CreateAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokensResponse, error)
+	DeleteAccessTokensFunc          func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, accessTokenId openapiTypes.UUID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensResponse, error)
+	GetKeysFunc                     func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetKeysResponse, error)
+	CreateKeysWithBodyFunc          func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKeysResponse, error)
+	CreateKeysFunc                  func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, body CreateKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKeysResponse, error)
+	DeleteKeysFunc                  func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, reqEditors ...RequestEditorFn) (*DeleteKeysResponse, error)
+	GeKeyFunc                       func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, params *GeKeyParams, reqEditors ...RequestEditorFn) (*GeKeyResponse, error)
+	UpdateKeysWithBodyFunc          func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKeysResponse, error)
+	UpdateKeysFunc                  func(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, body UpdateKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKeysResponse, error)
+	GetServiceAccountJwksFunc       func(ctx context.Context, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetServiceAccountJwksResponse, error)
+}
+
+var _ ClientWithResponsesInterface = &Fake{}
+
+// CreateTokenWithBody calls CreateTokenWithBodyFunc
+func (f *Fake) CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	if f.CreateTokenWithBodyFunc == nil {
+		return nil, notFaked("CreateTokenWithBody")
+	}
+	return f.CreateTokenWithBodyFunc(ctx, contentType, body, reqEditors...)
+}
+
+// CreateTokenWithFormdataBody calls CreateTokenWithFormdataBodyFunc
+func (f *Fake) CreateTokenWithFormdataBody(ctx context.Context, body CreateTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	if f.CreateTokenWithFormdataBodyFunc == nil {
+		return nil, notFaked("CreateTokenWithFormdataBody")
+	}
+	return f.CreateTokenWithFormdataBodyFunc(ctx, body, reqEditors...)
+}
+
+// Get calls GetFunc
+func (f *Fake) Get(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	if f.GetFunc == nil {
+		return nil, notFaked("Get")
+	}
+	return f.GetFunc(ctx, projectId, reqEditors...)
+}
+
+// CreateWithBody calls CreateWithBodyFunc
+func (f *Fake) CreateWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateWithBodyFunc == nil {
+		return nil, notFaked("CreateWithBody")
+	}
+	return f.CreateWithBodyFunc(ctx, projectId, contentType, body, reqEditors...)
+}
+
+// Create calls CreateFunc
+func (f *Fake) Create(ctx context.Context, projectId string, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	if f.CreateFunc == nil {
+		return nil, notFaked("Create")
+	}
+	return f.CreateFunc(ctx, projectId, body, reqEditors...)
+}
+
+// Delete calls DeleteFunc
+func (f *Fake) Delete(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*DeleteResponse, error) {
+	if f.DeleteFunc == nil {
+		return nil, notFaked("Delete")
+	}
+	return f.DeleteFunc(ctx, projectId, serviceAccountEmail, reqEditors...)
+}
+
+// GetAccessTokens calls GetAccessTokensFunc
+func (f *Fake) GetAccessTokens(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error) {
+	if f.GetAccessTokensFunc == nil {
+		return nil, notFaked("GetAccessTokens")
+	}
+	return f.GetAccessTokensFunc(ctx, projectId, serviceAccountEmail, reqEditors...)
+}
+
+// CreateAccessTokensWithBody calls CreateAccessTokensWithBodyFunc
+func (f *Fake) CreateAccessTokensWithBody(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokensResponse, error) {
+	if f.CreateAccessTokensWithBodyFunc == nil {
+		return nil, notFaked("CreateAccessTokensWithBody")
+	}
+	return f.CreateAccessTokensWithBodyFunc(ctx, projectId, serviceAccountEmail, contentType, body, reqEditors...)
+}
+
+// CreateAccessTokens calls CreateAccessTokensFunc
+func (f *Fake) CreateAccessTokens(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, body CreateAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokensResponse, error) {
+	if f.CreateAccessTokensFunc == nil {
+		return nil, notFaked("CreateAccessTokens")
+	}
+	return f.CreateAccessTokensFunc(ctx, projectId, serviceAccountEmail, body, reqEditors...)
+}
+
+// DeleteAccessTokens calls DeleteAccessTokensFunc
+func (f *Fake) DeleteAccessTokens(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, accessTokenId openapiTypes.UUID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensResponse, error) {
+	if f.DeleteAccessTokensFunc == nil {
+		return nil, notFaked("DeleteAccessTokens")
+	}
+	return f.DeleteAccessTokensFunc(ctx, projectId, serviceAccountEmail, accessTokenId, reqEditors...)
+}
+
+// GetKeys calls GetKeysFunc
+func (f *Fake) GetKeys(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetKeysResponse, error) {
+	if f.GetKeysFunc == nil {
+		return nil, notFaked("GetKeys")
+	}
+	return f.GetKeysFunc(ctx, projectId, serviceAccountEmail, reqEditors...)
+}
+
+// CreateKeysWithBody calls CreateKeysWithBodyFunc
+func (f *Fake) CreateKeysWithBody(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKeysResponse, error) {
+	if f.CreateKeysWithBodyFunc == nil {
+		return nil, notFaked("CreateKeysWithBody")
+	}
+	return f.CreateKeysWithBodyFunc(ctx, projectId, serviceAccountEmail, contentType, body, reqEditors...)
+}
+
+// CreateKeys calls CreateKeysFunc
+func (f *Fake) CreateKeys(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, body CreateKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKeysResponse, error) {
+	if f.CreateKeysFunc == nil {
+		return nil, notFaked("CreateKeys")
+	}
+	return f.CreateKeysFunc(ctx, projectId, serviceAccountEmail, body, reqEditors...)
+}
+
+// DeleteKeys calls DeleteKeysFunc
+func (f *Fake) DeleteKeys(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, reqEditors ...RequestEditorFn) (*DeleteKeysResponse, error) {
+	if f.DeleteKeysFunc == nil {
+		return nil, notFaked("DeleteKeys")
+	}
+	return f.DeleteKeysFunc(ctx, projectId, serviceAccountEmail, keyId, reqEditors...)
+}
+
+// GeKey calls GeKeyFunc
+func (f *Fake) GeKey(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, params *GeKeyParams, reqEditors ...RequestEditorFn) (*GeKeyResponse, error) {
+	if f.GeKeyFunc == nil {
+		return nil, notFaked("GeKey")
+	}
+	return f.GeKeyFunc(ctx, projectId, serviceAccountEmail, keyId, params, reqEditors...)
+}
+
+// UpdateKeysWithBody calls UpdateKeysWithBodyFunc
+func (f *Fake) UpdateKeysWithBody(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKeysResponse, error) {
+	if f.UpdateKeysWithBodyFunc == nil {
+		return nil, notFaked("UpdateKeysWithBody")
+	}
+	return f.UpdateKeysWithBodyFunc(ctx, projectId, serviceAccountEmail, keyId, contentType, body, reqEditors...)
+}
+
+// UpdateKeys calls UpdateKeysFunc
+func (f *Fake) UpdateKeys(ctx context.Context, projectId string, serviceAccountEmail openapiTypes.Email, keyId openapiTypes.UUID, body UpdateKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKeysResponse, error) {
+	if f.UpdateKeysFunc == nil {
+		return nil, notFaked("UpdateKeys")
+	}
+	return f.UpdateKeysFunc(ctx, projectId, serviceAccountEmail, keyId, body, reqEditors...)
+}
+
+// GetServiceAccountJwks calls GetServiceAccountJwksFunc
+func (f *Fake) GetServiceAccountJwks(ctx context.Context, serviceAccountEmail openapiTypes.Email, reqEditors ...RequestEditorFn) (*GetServiceAccountJwksResponse, error) {
+	if f.GetServiceAccountJwksFunc == nil {
+		return nil, notFaked("GetServiceAccountJwks")
+	}
+	return f.GetServiceAccountJwksFunc(ctx, serviceAccountEmail, reqEditors...)
+}
+
+func notFaked(method string) error {
+	return fmt.Errorf("fake: %s isn't implemented", method)
+}
@@ -11,5 +11,5 @@ var BaseURLs = baseurl.New(
 )
 
 func NewService(c contracts.BaseClientInterface) *ClientWithResponses {
-	return NewClient(BaseURLs.Get(), c)
+	return NewClient(BaseURLs.Get(), contracts.WithUserAgent(c, "service-accounts", "v2.0"))
 }
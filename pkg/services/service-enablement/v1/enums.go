@@ -0,0 +1,199 @@
+// Code generated by internal/tools/enums. DO NOT EDIT.
+
+package serviceenablement
+
+import "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+
+// Values returns the values of ActionErrorAction
+func (ActionErrorAction) Values() []ActionErrorAction {
+	return []ActionErrorAction{
+		DISABLE,
+		ENABLE,
+	}
+}
+
+// IsValid reports whether e is one of the values of ActionErrorAction
+func (e ActionErrorAction) IsValid() bool {
+	switch e {
+	case DISABLE, ENABLE:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ActionErrorAction) String() string {
+	return string(e)
+}
+
+// ParseActionErrorAction returns s as ActionErrorAction, or a *validate.EnumError if s isn't one of its values
+func ParseActionErrorAction(s string) (ActionErrorAction, error) {
+	return validate.ParseEnum[ActionErrorAction](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ActionErrorAction) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ParametersGeneralProjectScope
+func (ParametersGeneralProjectScope) Values() []ParametersGeneralProjectScope {
+	return []ParametersGeneralProjectScope{
+		PARAMETERS_GENERAL_PROJECT_SCOPE_PUBLIC,
+		PARAMETERS_GENERAL_PROJECT_SCOPE_SCHWARZ,
+	}
+}
+
+// IsValid reports whether e is one of the values of ParametersGeneralProjectScope
+func (e ParametersGeneralProjectScope) IsValid() bool {
+	switch e {
+	case PARAMETERS_GENERAL_PROJECT_SCOPE_PUBLIC, PARAMETERS_GENERAL_PROJECT_SCOPE_SCHWARZ:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ParametersGeneralProjectScope) String() string {
+	return string(e)
+}
+
+// ParseParametersGeneralProjectScope returns s as ParametersGeneralProjectScope, or a *validate.EnumError if s isn't one of its values
+func ParseParametersGeneralProjectScope(s string) (ParametersGeneralProjectScope, error) {
+	return validate.ParseEnum[ParametersGeneralProjectScope](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ParametersGeneralProjectScope) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectCloudServiceEnablement
+func (ProjectCloudServiceEnablement) Values() []ProjectCloudServiceEnablement {
+	return []ProjectCloudServiceEnablement{
+		AUTO,
+		REQUEST,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectCloudServiceEnablement
+func (e ProjectCloudServiceEnablement) IsValid() bool {
+	switch e {
+	case AUTO, REQUEST:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectCloudServiceEnablement) String() string {
+	return string(e)
+}
+
+// ParseProjectCloudServiceEnablement returns s as ProjectCloudServiceEnablement, or a *validate.EnumError if s isn't one of its values
+func ParseProjectCloudServiceEnablement(s string) (ProjectCloudServiceEnablement, error) {
+	return validate.ParseEnum[ProjectCloudServiceEnablement](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectCloudServiceEnablement) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectCloudServiceLifecycle
+func (ProjectCloudServiceLifecycle) Values() []ProjectCloudServiceLifecycle {
+	return []ProjectCloudServiceLifecycle{
+		FLEX,
+		PROJECT,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectCloudServiceLifecycle
+func (e ProjectCloudServiceLifecycle) IsValid() bool {
+	switch e {
+	case FLEX, PROJECT:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectCloudServiceLifecycle) String() string {
+	return string(e)
+}
+
+// ParseProjectCloudServiceLifecycle returns s as ProjectCloudServiceLifecycle, or a *validate.EnumError if s isn't one of its values
+func ParseProjectCloudServiceLifecycle(s string) (ProjectCloudServiceLifecycle, error) {
+	return validate.ParseEnum[ProjectCloudServiceLifecycle](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectCloudServiceLifecycle) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectCloudServiceScope
+func (ProjectCloudServiceScope) Values() []ProjectCloudServiceScope {
+	return []ProjectCloudServiceScope{
+		PROJECT_CLOUD_SERVICE_SCOPE_PRIVATE,
+		PROJECT_CLOUD_SERVICE_SCOPE_PUBLIC,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectCloudServiceScope
+func (e ProjectCloudServiceScope) IsValid() bool {
+	switch e {
+	case PROJECT_CLOUD_SERVICE_SCOPE_PRIVATE, PROJECT_CLOUD_SERVICE_SCOPE_PUBLIC:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectCloudServiceScope) String() string {
+	return string(e)
+}
+
+// ParseProjectCloudServiceScope returns s as ProjectCloudServiceScope, or a *validate.EnumError if s isn't one of its values
+func ParseProjectCloudServiceScope(s string) (ProjectCloudServiceScope, error) {
+	return validate.ParseEnum[ProjectCloudServiceScope](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectCloudServiceScope) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
+
+// Values returns the values of ProjectCloudServiceState
+func (ProjectCloudServiceState) Values() []ProjectCloudServiceState {
+	return []ProjectCloudServiceState{
+		DISABLED,
+		DISABLING,
+		ENABLED,
+		ENABLING,
+	}
+}
+
+// IsValid reports whether e is one of the values of ProjectCloudServiceState
+func (e ProjectCloudServiceState) IsValid() bool {
+	switch e {
+	case DISABLED, DISABLING, ENABLED, ENABLING:
+		return true
+	}
+	return false
+}
+
+// String returns e as string
+func (e ProjectCloudServiceState) String() string {
+	return string(e)
+}
+
+// ParseProjectCloudServiceState returns s as ProjectCloudServiceState, or a *validate.EnumError if s isn't one of its values
+func ParseProjectCloudServiceState(s string) (ProjectCloudServiceState, error) {
+	return validate.ParseEnum[ProjectCloudServiceState](s)
+}
+
+// UnmarshalJSON unmarshals e, unknown values are rejected if validate.StrictEnums is set
+func (e *ProjectCloudServiceState) UnmarshalJSON(b []byte) error {
+	return validate.UnmarshalEnum(b, e)
+}
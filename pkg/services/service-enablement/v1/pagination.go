@@ -0,0 +1,28 @@
+package serviceenablement
+
+import (
+	"context"
+	"iter"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/pagination"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// ListServicesIter returns an iterator over all services of a project
+func ListServicesIter(ctx context.Context, c ClientWithResponsesInterface, projectID string) iter.Seq2[ProjectCloudService, error] {
+	return pagination.Cursor(func(cursor string) ([]ProjectCloudService, string, error) {
+		p := ListServicesParams{}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		res, err := c.ListServices(ctx, projectID, &p)
+		if err = validate.Response(res, err, "JSON200.Items"); err != nil {
+			return nil, "", err
+		}
+		next := ""
+		if res.JSON200.NextCursor != nil {
+			next = *res.JSON200.NextCursor
+		}
+		return *res.JSON200.Items, next, nil
+	})
+}
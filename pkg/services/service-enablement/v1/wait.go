@@ -3,32 +3,31 @@ package serviceenablement
 import (
 	"context"
 	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
-	"github.com/pkg/errors"
 )
 
-func (*EnableServiceResponse) WaitHandler(ctx context.Context, c *ClientWithResponses, projectID, serviceID string) *wait.Handler {
-	return wait.New(func() (res interface{}, done bool, err error) {
-
+// WaitHandler will wait for the service to be enabled
+// returned value is always empty
+func (*EnableServiceResponse) WaitHandler(ctx context.Context, c ClientWithResponsesInterface, projectID, serviceID string) *wait.Handler[struct{}] {
+	return wait.NewHandler(func() (res struct{}, done bool, err error) {
 		resp, err := c.GetService(ctx, projectID, serviceID)
-		if err != nil {
-			return nil, false, errors.Wrap(err, "failed during create request preparation")
-		}
-		if err != nil {
-			return nil, false, err
+		if err = validate.Response(resp, err, "JSON200.State"); err != nil {
+			return struct{}{}, false, wait.NewRequestError(resp, err)
 		}
 
 		switch *resp.JSON200.State {
 		case DISABLING:
-			return nil, false, fmt.Errorf("received state: %s for project ID: %s and service ID: %s",
+			return struct{}{}, false, fmt.Errorf("received state: %s for project ID: %s and service ID: %s",
 				*resp.JSON200.State,
 				projectID,
-				*resp.JSON200.ServiceID,
+				serviceID,
 			)
 		case ENABLED:
-			return nil, true, nil
+			return struct{}{}, true, nil
 		}
 
-		return nil, false, nil
-	})
+		return struct{}{}, false, nil
+	}).Describe("service-enablement", "service.enable", "enabled", map[string]string{"projectID": projectID, "serviceID": serviceID})
 }
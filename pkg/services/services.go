@@ -5,7 +5,6 @@ import (
 	scf "github.com/SchwarzIT/community-stackit-go-client/pkg/services/scf/v1.0"
 	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
 
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
 	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
 	costs "github.com/SchwarzIT/community-stackit-go-client/pkg/services/costs/v2.0"
@@ -24,30 +23,34 @@ import (
 
 type Services struct {
 	Client             contracts.BaseClientInterface
-	Argus              *argus.ClientWithResponses
-	Costs              *costs.ClientWithResponses
-	IAAS               *iaas.ClientWithResponses
-	Kubernetes         *kubernetes.ClientWithResponses
-	LoadBalancer       *loadbalancer.ClientWithResponses
-	Membership         *membership.ClientWithResponses
-	MongoDBFlex        *mongodbflex.ClientWithResponses
-	ObjectStorage      *objectstorage.ClientWithResponses
-	PostgresFlex       *postgresflex.ClientWithResponses
-	ResourceManagement *resourcemanagement.ClientWithResponses
-	SecretsManager     *secretsmanager.ClientWithResponses
-	ServiceAccounts    *serviceaccounts.ClientWithResponses
-	ServiceEnablement  *serviceenablement.ClientWithResponses
-	SCF                *scf.ClientWithResponses
+	Argus              *argus.Service
+	Costs              costs.ClientWithResponsesInterface
+	IAAS               *iaas.Service
+	Kubernetes         *kubernetes.Service
+	LoadBalancer       *loadbalancer.Service
+	Membership         membership.ClientWithResponsesInterface
+	MongoDBFlex        *mongodbflex.Service
+	ObjectStorage      *objectstorage.Service
+	PostgresFlex       *postgresflex.Service
+	ResourceManagement resourcemanagement.ClientWithResponsesInterface
+	SecretsManager     *secretsmanager.Service
+	ServiceAccounts    serviceaccounts.ClientWithResponsesInterface
+	ServiceEnablement  serviceenablement.ClientWithResponsesInterface
+	SCF                *scf.Service
 
 	// DSA
-	ElasticSearch *dataservices.ClientWithResponses
-	LogMe         *dataservices.ClientWithResponses
-	MariaDB       *dataservices.ClientWithResponses
-	MongoDB       *dataservices.ClientWithResponses
-	Opensearch    *dataservices.ClientWithResponses
-	PostgresDB    *dataservices.ClientWithResponses
-	RabbitMQ      *dataservices.ClientWithResponses
-	Redis         *dataservices.ClientWithResponses
+	ElasticSearch *dataservices.Service
+	LogMe         *dataservices.Service
+	MariaDB       *dataservices.Service
+	MongoDB       *dataservices.Service
+	Opensearch    *dataservices.Service
+	PostgresDB    *dataservices.Service
+	RabbitMQ      *dataservices.Service
+	Redis         *dataservices.Service
+
+	// Resolver resolves the base URL hosts of the enabled services in Check
+	// net.DefaultResolver is used if it's nil
+	Resolver HostResolver
 }
 
 func Init(c contracts.BaseClientInterface) (*Services, error) {
@@ -87,12 +90,8 @@ func Init(c contracts.BaseClientInterface) (*Services, error) {
 }
 
 func newClient(c contracts.BaseClientInterface) contracts.BaseClientInterface {
-	nc := c.Clone()
-	if v, ok := nc.(*clients.KeyFlow); ok {
-		return contracts.BaseClientInterface(v)
-	}
-	if v, ok := nc.(*clients.TokenFlow); ok {
-		return contracts.BaseClientInterface(v)
+	if nc, ok := c.Clone().(contracts.BaseClientInterface); ok {
+		return nc
 	}
 	return nil
 }
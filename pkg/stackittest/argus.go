@@ -0,0 +1,76 @@
+package stackittest
+
+import (
+	"net/http"
+
+	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/google/uuid"
+)
+
+// argusInstance is an Argus instance, its status is the one reported once it's ready
+type argusInstance = instances.ProjectInstanceUI
+
+// registerArgus registers the Argus instance and backup restore routes
+func (s *Server) registerArgus() {
+	b := argus.BaseURLs
+	s.handle(http.MethodPost, b, "/v1/projects/{projectID}/instances", s.createArgusInstance)
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/instances/{instanceID}", s.getArgusInstance)
+	s.handle(http.MethodPost, b, "/v1/projects/{projectID}/instances/{instanceID}/backup-restores/{backupDate}", s.restoreArgusBackup)
+}
+
+// renderArgusInstance returns the instance with its status
+func renderArgusInstance(e *entry[argusInstance]) argusInstance {
+	inst := e.obj
+	switch e.phase {
+	case phaseCreating:
+		inst.Status = instances.PROJECT_INSTANCE_UI_STATUS_CREATING
+	case phaseUpdating:
+		inst.Status = instances.PROJECT_INSTANCE_UI_STATUS_UPDATING
+	}
+	return inst
+}
+
+func (s *Server) createArgusInstance(w http.ResponseWriter, r *http.Request) {
+	body := instances.CreateJSONBody{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	id := uuid.NewString()
+	s.argus.add(key(r.PathValue("projectID"), id), argusInstance{
+		ID:     id,
+		Name:   body.Name,
+		PlanID: body.PlanID,
+		Status: instances.PROJECT_INSTANCE_UI_STATUS_CREATE_SUCCEEDED,
+	})
+	writeJSON(w, http.StatusAccepted, instances.ProjectInstancesCreateResponse{InstanceID: id, Message: "Successfully created instance"})
+}
+
+func (s *Server) getArgusInstance(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.argus.get(key(r.PathValue("projectID"), r.PathValue("instanceID")), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderArgusInstance(e))
+}
+
+// restoreArgusBackup moves the instance to the updating phase, it reports UPDATE_SUCCEEDED once the restore is applied
+func (s *Server) restoreArgusBackup(w http.ResponseWriter, r *http.Request) {
+	if _, ok := query(w, r, "restoreTarget"); !ok {
+		return
+	}
+	k := key(r.PathValue("projectID"), r.PathValue("instanceID"))
+	e, ok := s.argus.items[k]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	inst := e.obj
+	inst.Status = instances.PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED
+	if !s.argus.update(k, inst) {
+		writeError(w, http.StatusConflict, "instance is being deleted")
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]string{"message": "Restore triggered"})
+}
@@ -0,0 +1,211 @@
+package stackittest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// tokenKeyID identifies the key the fake server signs tokens with
+	tokenKeyID = "stackittest"
+
+	accessTokenTTL  = time.Hour
+	refreshTokenTTL = 24 * time.Hour
+)
+
+var tokenAPI = baseurl.New(
+	"token",
+	"https://service-account.api.stackit.cloud/token",
+)
+
+var jwksAPI = baseurl.New(
+	"jwks",
+	"https://service-account.api.stackit.cloud/.well-known/jwks.json",
+)
+
+// KeyFlowConfig creates a new service account key and returns a key flow configuration using it
+// the configuration uses Transport, so the key flow authenticates against the fake server
+func (s *Server) KeyFlowConfig() clients.KeyFlowConfig {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		panic(err)
+	}
+
+	sa := clients.ServiceAccountKeyPrivateResponse{
+		Active:       true,
+		CreatedAt:    time.Now().UTC(),
+		ID:           uuid.New(),
+		KeyAlgorithm: "RSA_2048",
+		KeyOrigin:    "GENERATED",
+		KeyType:      "USER_MANAGED",
+		PublicKey:    string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub})),
+	}
+	sa.Credentials.Aud = tokenAPI.Get()
+	sa.Credentials.Kid = uuid.NewString()
+	sa.Credentials.Sub = uuid.New()
+	sa.Credentials.Iss = fmt.Sprintf("%s@sa.stackit.cloud", sa.Credentials.Sub.String()[:8])
+	saJSON, err := json.Marshal(sa)
+	if err != nil {
+		panic(err)
+	}
+
+	s.mu.Lock()
+	s.saKeys[sa.Credentials.Kid] = &key.PublicKey
+	s.mu.Unlock()
+
+	return clients.KeyFlowConfig{
+		ServiceAccountKey: saJSON,
+		PrivateKey: pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}),
+		Transport: s.Transport(),
+	}
+}
+
+// TokenFlowConfig returns a token flow configuration with an access token issued by the fake server
+// the configuration uses Transport, so the token flow authenticates against the fake server
+func (s *Server) TokenFlowConfig() clients.TokenFlowConfig {
+	sub := uuid.NewString()
+	token, err := s.signToken(sub, uuid.NewString(), accessTokenTTL)
+	if err != nil {
+		panic(err)
+	}
+	return clients.TokenFlowConfig{
+		ServiceAccountEmail: fmt.Sprintf("%s@sa.stackit.cloud", sub[:8]),
+		ServiceAccountToken: token,
+		Transport:           s.Transport(),
+	}
+}
+
+// registerAuth registers the token and JWKS endpoints
+func (s *Server) registerAuth() {
+	s.mux.HandleFunc(pattern(http.MethodPost, tokenAPI, ""), s.token)
+	s.mux.HandleFunc(pattern(http.MethodGet, jwksAPI, ""), s.jwks)
+}
+
+// token issues an access token for a self signed service account JWT or a refresh token
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var claims jwt.RegisteredClaims
+	var err error
+	switch r.PostForm.Get("grant_type") {
+	case "urn:ietf:params:oauth:grant-type:jwt-bearer":
+		_, err = jwt.ParseWithClaims(r.PostForm.Get("assertion"), &claims, s.serviceAccountKey)
+	case "refresh_token":
+		_, err = jwt.ParseWithClaims(r.PostForm.Get("assertion"), &claims, s.tokenKey)
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported grant type")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	access, err := s.signToken(claims.Subject, uuid.NewString(), accessTokenTTL)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	refresh, err := s.signToken(claims.Subject, uuid.NewString(), refreshTokenTTL)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, clients.TokenResponseBody{
+		AccessToken:  access,
+		ExpiresIn:    int(accessTokenTTL.Seconds()),
+		RefreshToken: refresh,
+		Scope:        "",
+		TokenType:    "Bearer",
+	})
+}
+
+// jwks returns the key set used to validate tokens issued by the fake server
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := s.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": jwt.SigningMethodRS512.Alg(),
+			"use": "sig",
+			"kid": tokenKeyID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// signToken returns a token with the given subject and ID signed by the fake server
+func (s *Server) signToken(subject, id string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.RegisteredClaims{
+		Subject:   subject,
+		ID:        id,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	})
+	token.Header["kid"] = tokenKeyID
+	return token.SignedString(s.key)
+}
+
+// authorized reports whether the request carries a valid access token, which hasn't been revoked
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	var claims jwt.RegisteredClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, s.tokenKey); err != nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.revoked[claims.ID]
+}
+
+// tokenKey returns the key to validate tokens issued by the fake server
+func (s *Server) tokenKey(t *jwt.Token) (interface{}, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+	}
+	return &s.key.PublicKey, nil
+}
+
+// serviceAccountKey returns the public key of the service account key that signed t
+func (s *Server) serviceAccountKey(t *jwt.Token) (interface{}, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+	}
+	kid, _ := t.Header["kid"].(string)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key, ok := s.saKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown service account key %q", kid)
+	}
+	return key, nil
+}
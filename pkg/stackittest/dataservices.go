@@ -0,0 +1,129 @@
+package stackittest
+
+import (
+	"fmt"
+	"net/http"
+
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/google/uuid"
+)
+
+type dsaInstance = instances.Instance
+
+// dsaServices are the DSA services served by the fake server
+var dsaServices = []int{
+	dataservices.ElasticSearch,
+	dataservices.LogMe,
+	dataservices.MariaDB,
+	dataservices.Opensearch,
+	dataservices.PostgresDB,
+	dataservices.RabbitMQ,
+	dataservices.Redis,
+}
+
+// registerDataServices registers the instance routes of all DSA services
+// instances of different services are kept apart by the service ID
+func (s *Server) registerDataServices() {
+	for _, id := range dsaServices {
+		b := dataservices.GetBaseURLs(id)
+		svc := fmt.Sprint(id)
+		s.handle(http.MethodGet, b, "/v1/projects/{projectID}/instances", func(w http.ResponseWriter, r *http.Request) {
+			items := []dsaInstance{}
+			for _, e := range s.dsa.list(key(svc, r.PathValue("projectID"), ""), s.reads) {
+				items = append(items, renderDSAInstance(e))
+			}
+			writeJSON(w, http.StatusOK, instances.InstanceList{Instances: items})
+		})
+		s.handle(http.MethodPost, b, "/v1/projects/{projectID}/instances", func(w http.ResponseWriter, r *http.Request) {
+			s.provisionDSAInstance(w, r, svc)
+		})
+		s.handle(http.MethodGet, b, "/v1/projects/{projectID}/instances/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+			e, ok := s.dsa.get(key(svc, r.PathValue("projectID"), r.PathValue("instanceID")), s.reads)
+			if !ok {
+				writeError(w, http.StatusNotFound, "instance not found")
+				return
+			}
+			writeJSON(w, http.StatusOK, renderDSAInstance(e))
+		})
+		s.handle(http.MethodPatch, b, "/v1/projects/{projectID}/instances/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+			s.updateDSAInstance(w, r, svc)
+		})
+		s.handle(http.MethodDelete, b, "/v1/projects/{projectID}/instances/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+			if !s.dsa.remove(key(svc, r.PathValue("projectID"), r.PathValue("instanceID"))) {
+				writeError(w, http.StatusNotFound, "instance not found")
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		})
+	}
+}
+
+// SetDSAOperation makes the fake server report op as last operation of the instance of the DSA service, i.e. to simulate a failure
+// it returns false if there is no such instance
+func (s *Server) SetDSAOperation(serviceID int, projectID, instanceID string, op instances.LastOperation) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dsa.setFault(key(fmt.Sprint(serviceID), projectID, instanceID), func(inst *dsaInstance) {
+		inst.LastOperation = op
+	})
+}
+
+// renderDSAInstance returns the instance with its last operation
+func renderDSAInstance(e *entry[dsaInstance]) dsaInstance {
+	inst := e.obj
+	inst.LastOperation.State = instances.IN_PROGRESS
+	switch e.phase {
+	case phaseReady:
+		inst.LastOperation.State = instances.SUCCEEDED
+	case phaseDeleting:
+		inst.LastOperation.Type = instances.DELETE
+	}
+	inst.LastOperation.Description = fmt.Sprintf("%s %s", inst.LastOperation.Type, inst.LastOperation.State)
+	return e.withFault(inst)
+}
+
+func (s *Server) provisionDSAInstance(w http.ResponseWriter, r *http.Request, svc string) {
+	body := instances.InstanceProvisionRequest{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	projectID := r.PathValue("projectID")
+	for _, e := range s.dsa.list(key(svc, projectID, ""), s.reads) {
+		if e.obj.Name == body.InstanceName {
+			writeError(w, http.StatusConflict, "instance name already in use")
+			return
+		}
+	}
+	id := uuid.NewString()
+	s.dsa.add(key(svc, projectID, id), dsaInstance{
+		InstanceID:    &id,
+		Name:          body.InstanceName,
+		PlanID:        body.PlanID,
+		Parameters:    instances.Object{},
+		DashboardUrl:  fmt.Sprintf("https://dashboard.example.com/%s", id),
+		LastOperation: instances.LastOperation{Type: instances.CREATE},
+	})
+	writeJSON(w, http.StatusAccepted, instances.InstanceID{InstanceID: id})
+}
+
+func (s *Server) updateDSAInstance(w http.ResponseWriter, r *http.Request, svc string) {
+	body := instances.InstanceUpdateRequest{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	k := key(svc, r.PathValue("projectID"), r.PathValue("instanceID"))
+	e, ok := s.dsa.items[k]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	inst := e.obj
+	inst.PlanID = body.PlanID
+	inst.LastOperation.Type = instances.UPDATE
+	if !s.dsa.update(k, inst) {
+		writeError(w, http.StatusConflict, "instance is being deleted")
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
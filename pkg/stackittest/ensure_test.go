@@ -0,0 +1,166 @@
+package stackittest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/ptr"
+	dsainstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	skeproject "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/project"
+	lbinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	mongodbinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	osbucket "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/bucket"
+	postgresinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	smInstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertEnsure asserts the ensure helpers of a resource only create or delete it if needed
+// exists and deleted call EnsureExists and EnsureDeleted
+func assertEnsure[ID comparable](t *testing.T, exists func() (ID, bool, error), deleted func(ID) (bool, error)) {
+	id, created, err := exists()
+	require.NoError(t, err)
+	assert.True(t, created, "a missing resource should be created")
+
+	again, created, err := exists()
+	require.NoError(t, err)
+	assert.False(t, created, "an existing resource shouldn't be created")
+	assert.Equal(t, id, again, "the existing resource should be returned")
+
+	ok, err := deleted(id)
+	require.NoError(t, err)
+	assert.True(t, ok, "an existing resource should be deleted")
+
+	ok, err = deleted(id)
+	require.NoError(t, err)
+	assert.False(t, ok, "a missing resource shouldn't be deleted")
+}
+
+func TestEnsure_Kubernetes(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return skeproject.EnsureExists(ctx, c.Kubernetes.Project, projectID)
+	}, func(id string) (bool, error) {
+		return skeproject.EnsureDeleted(ctx, c.Kubernetes.Project, id)
+	})
+
+	body := cluster.CreateOrUpdateJSONRequestBody{Kubernetes: cluster.Kubernetes{Version: "1.27"}, Nodepools: []cluster.Nodepool{}}
+	assertEnsure(t, func() (string, bool, error) {
+		return cluster.EnsureExists(ctx, c.Kubernetes.Cluster, projectID, "my-cluster", body)
+	}, func(name string) (bool, error) {
+		return cluster.EnsureDeleted(ctx, c.Kubernetes.Cluster, projectID, name)
+	})
+}
+
+func TestEnsure_ObjectStorage(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return osbucket.EnsureExists(ctx, c.ObjectStorage.Bucket, projectID, "my-bucket")
+	}, func(name string) (bool, error) {
+		return osbucket.EnsureDeleted(ctx, c.ObjectStorage.Bucket, projectID, name)
+	})
+}
+
+func TestEnsure_LoadBalancer(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return lbinstances.EnsureExists(ctx, c.LoadBalancer.Instances, projectID, lbinstances.LoadBalancer{Name: ptr.Of("my-lb")})
+	}, func(name string) (bool, error) {
+		return lbinstances.EnsureDeleted(ctx, c.LoadBalancer.Instances, projectID, name)
+	})
+}
+
+func TestEnsure_PostgresFlex(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return postgresinstance.EnsureExists(ctx, c.PostgresFlex.Instance, projectID, postgresinstance.InstanceCreateInstanceRequest{Name: ptr.Of("my-instance")})
+	}, func(id string) (bool, error) {
+		return postgresinstance.EnsureDeleted(ctx, c.PostgresFlex.Instance, projectID, id)
+	})
+}
+
+func TestEnsure_MongoDBFlex(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return mongodbinstance.EnsureExists(ctx, c.MongoDBFlex.Instance, projectID, mongodbinstance.InstanceCreateInstanceRequest{Name: ptr.Of("my-instance")})
+	}, func(id string) (bool, error) {
+		return mongodbinstance.EnsureDeleted(ctx, c.MongoDBFlex.Instance, projectID, id)
+	})
+}
+
+func TestEnsure_DataServices(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	assertEnsure(t, func() (string, bool, error) {
+		return dsainstances.EnsureExists(ctx, c.Redis.Instances, projectID, dsainstances.InstanceProvisionRequest{InstanceName: "my-redis", PlanID: "plan"})
+	}, func(id string) (bool, error) {
+		return dsainstances.EnsureDeleted(ctx, c.Redis.Instances, projectID, id)
+	})
+}
+
+func TestEnsure_SecretsManager(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	pid := uuid.MustParse(projectID)
+	assertEnsure(t, func() (uuid.UUID, bool, error) {
+		return smInstances.EnsureExists(ctx, c.SecretsManager.Instances, pid, smInstances.InstanceCreate{Name: "my-secrets"})
+	}, func(id uuid.UUID) (bool, error) {
+		return smInstances.EnsureDeleted(ctx, c.SecretsManager.Instances, pid, id)
+	})
+}
+
+func TestEnsure_ResourceManagement(t *testing.T) {
+	t.Parallel()
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	body := resourcemanagement.ProjectRequestBody{Name: "my-project", ContainerParentID: "organization-1", Members: []resourcemanagement.ProjectMember{}}
+	assertEnsure(t, func() (string, bool, error) {
+		return resourcemanagement.EnsureExists(ctx, c.ResourceManagement, body)
+	}, func(id string) (bool, error) {
+		return resourcemanagement.EnsureDeleted(ctx, c.ResourceManagement, id)
+	})
+
+	// projects are looked up in the parent of the body only
+	other := srv.AddProject("my-project", "organization-2")
+	id, created, err := resourcemanagement.EnsureExists(ctx, c.ResourceManagement, body)
+	require.NoError(t, err)
+	assert.True(t, created, "a project with the same name in another parent shouldn't be found")
+	assert.NotEqual(t, other, id)
+
+	again, created, err := resourcemanagement.EnsureExists(ctx, c.ResourceManagement, body)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, id, again)
+}
@@ -0,0 +1,153 @@
+package stackittest
+
+import (
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	mongodbflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0"
+	mongodbinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	postgresflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0"
+	postgresinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	"github.com/google/uuid"
+)
+
+// flexInstance is the create or update payload of a flex instance
+// Postgres and MongoDB flex share the same instance model
+type flexInstance = map[string]interface{}
+
+// flexAPI describes the differences between the flex services
+type flexAPI struct {
+	store      *store[flexInstance]
+	createCode int
+	ready      string
+	processing string
+
+	// deleted is the status of soft-deleted instances, which are returned after their deletion
+	// instances aren't soft-deleted if it's empty
+	deleted     string
+	softDeleted map[string]flexInstance
+}
+
+// registerFlex registers the Postgres and MongoDB flex instance routes
+func (s *Server) registerFlex() {
+	s.registerFlexAPI(postgresflex.BaseURLs, "/v1", flexAPI{
+		store:       s.postgres,
+		createCode:  http.StatusCreated,
+		ready:       string(postgresinstance.STATUS_READY),
+		processing:  string(postgresinstance.STATUS_PROCESSING),
+		deleted:     string(postgresinstance.STATUS_DELETED),
+		softDeleted: map[string]flexInstance{},
+	})
+	s.registerFlexAPI(mongodbflex.BaseURLs, "", flexAPI{
+		store:      s.mongodb,
+		createCode: http.StatusAccepted,
+		ready:      string(mongodbinstance.STATUS_READY),
+		processing: string(mongodbinstance.STATUS_PROCESSING),
+	})
+}
+
+func (s *Server) registerFlexAPI(b baseurl.BaseURL, prefix string, api flexAPI) {
+	s.handle(http.MethodGet, b, prefix+"/projects/{projectID}/instances", func(w http.ResponseWriter, r *http.Request) {
+		items := []map[string]interface{}{}
+		for _, e := range api.store.list(key(r.PathValue("projectID"), ""), s.reads) {
+			inst := api.render(e)
+			items = append(items, map[string]interface{}{"id": inst["id"], "name": inst["name"], "status": inst["status"]})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(items), "items": items})
+	})
+	s.handle(http.MethodPost, b, prefix+"/projects/{projectID}/instances", func(w http.ResponseWriter, r *http.Request) {
+		body := flexInstance{}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		id := uuid.NewString()
+		body["id"] = id
+		api.store.add(key(r.PathValue("projectID"), id), body)
+		writeJSON(w, api.createCode, map[string]string{"id": id})
+	})
+	s.handle(http.MethodGet, b, prefix+"/projects/{projectID}/instances/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+		k := key(r.PathValue("projectID"), r.PathValue("instanceID"))
+		e, ok := api.store.get(k, s.reads)
+		if !ok {
+			if inst, deleted := api.softDeleted[k]; deleted {
+				writeJSON(w, http.StatusOK, map[string]interface{}{"item": inst})
+				return
+			}
+			writeError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"item": api.render(e)})
+	})
+	update := func(w http.ResponseWriter, r *http.Request) {
+		k := key(r.PathValue("projectID"), r.PathValue("instanceID"))
+		e, ok := api.store.items[k]
+		if !ok {
+			writeError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		body := flexInstance{}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		inst := flexInstance{}
+		for _, m := range []flexInstance{e.obj, body} {
+			for k, v := range m {
+				inst[k] = v
+			}
+		}
+		if !api.store.update(k, inst) {
+			writeError(w, http.StatusConflict, "instance is being deleted")
+			return
+		}
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{"item": api.render(e)})
+	}
+	s.handle(http.MethodPut, b, prefix+"/projects/{projectID}/instances/{instanceID}", update)
+	s.handle(http.MethodPatch, b, prefix+"/projects/{projectID}/instances/{instanceID}", update)
+	s.handle(http.MethodDelete, b, prefix+"/projects/{projectID}/instances/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+		k := key(r.PathValue("projectID"), r.PathValue("instanceID"))
+		e, ok := api.store.items[k]
+		if !ok || !api.store.remove(k) {
+			writeError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		if api.deleted != "" {
+			inst := api.render(e)
+			inst["status"] = api.deleted
+			api.softDeleted[k] = inst
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// SetPostgresFlexStatus makes the fake server report status for the Postgres Flex instance, i.e. to simulate a failure
+// it returns false if there is no such instance
+func (s *Server) SetPostgresFlexStatus(projectID, instanceID string, status postgresinstance.Status) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.postgres.setFault(key(projectID, instanceID), func(inst *flexInstance) {
+		(*inst)["status"] = string(status)
+	})
+}
+
+// SetMongoDBFlexStatus makes the fake server report status for the MongoDB Flex instance, i.e. to simulate a failure
+// it returns false if there is no such instance
+func (s *Server) SetMongoDBFlexStatus(projectID, instanceID string, status mongodbinstance.Status) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mongodb.setFault(key(projectID, instanceID), func(inst *flexInstance) {
+		(*inst)["status"] = string(status)
+	})
+}
+
+// render returns the instance with its status
+func (api flexAPI) render(e *entry[flexInstance]) flexInstance {
+	inst := flexInstance{}
+	for k, v := range e.obj {
+		inst[k] = v
+	}
+	inst["status"] = api.ready
+	if e.phase != phaseReady {
+		inst["status"] = api.processing
+	}
+	return e.withFault(inst)
+}
@@ -0,0 +1,151 @@
+package stackittest
+
+import (
+	"net/http"
+	"time"
+
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/project"
+)
+
+type skeProject = project.Project
+
+type skeCluster = cluster.Cluster
+
+// registerKubernetes registers the SKE project and cluster routes
+func (s *Server) registerKubernetes() {
+	b := kubernetes.BaseURLs
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}", s.getSKEProject)
+	s.handle(http.MethodPut, b, "/v1/projects/{projectID}", s.createSKEProject)
+	s.handle(http.MethodDelete, b, "/v1/projects/{projectID}", s.deleteSKEProject)
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/clusters", s.listClusters)
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/clusters/{clusterName}", s.getCluster)
+	s.handle(http.MethodPut, b, "/v1/projects/{projectID}/clusters/{clusterName}", s.createOrUpdateCluster)
+	s.handle(http.MethodDelete, b, "/v1/projects/{projectID}/clusters/{clusterName}", s.deleteCluster)
+}
+
+// renderSKEProject returns the SKE project with its state
+func renderSKEProject(e *entry[skeProject]) skeProject {
+	p := e.obj
+	state := project.STATE_CREATED
+	switch e.phase {
+	case phaseCreating:
+		state = project.STATE_CREATING
+	case phaseDeleting:
+		state = project.STATE_DELETING
+	}
+	p.State = &state
+	return p
+}
+
+func (s *Server) getSKEProject(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.skeProjects.get(r.PathValue("projectID"), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderSKEProject(e))
+}
+
+func (s *Server) createSKEProject(w http.ResponseWriter, r *http.Request) {
+	projectID := r.PathValue("projectID")
+	e, ok := s.skeProjects.items[projectID]
+	if !ok {
+		e = s.skeProjects.add(projectID, skeProject{ProjectID: &projectID})
+	}
+	writeJSON(w, http.StatusOK, renderSKEProject(e))
+}
+
+func (s *Server) deleteSKEProject(w http.ResponseWriter, r *http.Request) {
+	projectID := r.PathValue("projectID")
+	if len(s.clusters.list(key(projectID, ""), s.reads)) > 0 {
+		writeError(w, http.StatusBadRequest, "project has clusters")
+		return
+	}
+	if !s.skeProjects.remove(projectID) {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// SetClusterStatus makes the fake server report status for the cluster, i.e. to simulate a failure
+// it returns false if there is no such cluster
+func (s *Server) SetClusterStatus(projectID, clusterName string, status cluster.ClusterStatus) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clusters.setFault(key(projectID, clusterName), func(c *skeCluster) {
+		st := status
+		c.Status = &st
+	})
+}
+
+// renderCluster returns the cluster with its aggregated status
+func renderCluster(e *entry[skeCluster]) skeCluster {
+	c := e.obj
+	state := cluster.STATE_HEALTHY
+	switch e.phase {
+	case phaseCreating:
+		state = cluster.STATE_CREATING
+	case phaseUpdating:
+		state = cluster.STATE_RECONCILING
+	case phaseDeleting:
+		state = cluster.STATE_DELETING
+	}
+	status := cluster.ClusterStatus{Aggregated: &state}
+	if c.Status != nil {
+		status.CreationTime = c.Status.CreationTime
+	}
+	c.Status = &status
+	return e.withFault(c)
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	items := []skeCluster{}
+	for _, e := range s.clusters.list(key(r.PathValue("projectID"), ""), s.reads) {
+		items = append(items, renderCluster(e))
+	}
+	writeJSON(w, http.StatusOK, cluster.Clusters{Items: &items})
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.clusters.get(key(r.PathValue("projectID"), r.PathValue("clusterName")), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "cluster not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderCluster(e))
+}
+
+func (s *Server) createOrUpdateCluster(w http.ResponseWriter, r *http.Request) {
+	body := skeCluster{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	name := r.PathValue("clusterName")
+	body.Name = &name
+
+	k := key(r.PathValue("projectID"), name)
+	if e, ok := s.clusters.items[k]; ok {
+		body.Status = e.obj.Status
+		if !s.clusters.update(k, body) {
+			writeError(w, http.StatusConflict, "cluster is being deleted")
+			return
+		}
+		writeJSON(w, http.StatusOK, renderCluster(e))
+		return
+	}
+
+	created := time.Now().UTC().Format(time.RFC3339)
+	body.Status = &cluster.ClusterStatus{CreationTime: &created}
+	writeJSON(w, http.StatusOK, renderCluster(s.clusters.add(k, body)))
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	if !s.clusters.remove(key(r.PathValue("projectID"), r.PathValue("clusterName"))) {
+		writeError(w, http.StatusNotFound, "cluster not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
@@ -0,0 +1,108 @@
+package stackittest
+
+import (
+	"net/http"
+
+	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+)
+
+type loadBalancer = instances.LoadBalancer
+
+// registerLoadBalancer registers the load balancer routes
+func (s *Server) registerLoadBalancer() {
+	b := loadbalancer.BaseURLs
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/load-balancers", s.listLoadBalancers)
+	s.handle(http.MethodPost, b, "/v1/projects/{projectID}/load-balancers", s.createLoadBalancer)
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/load-balancers/{name}", s.getLoadBalancer)
+	s.handle(http.MethodPut, b, "/v1/projects/{projectID}/load-balancers/{name}", s.updateLoadBalancer)
+	s.handle(http.MethodDelete, b, "/v1/projects/{projectID}/load-balancers/{name}", s.deleteLoadBalancer)
+}
+
+// SetLoadBalancerStatus makes the fake server report status and errs for the load balancer, i.e. to simulate a failure
+// it returns false if there is no such load balancer
+func (s *Server) SetLoadBalancerStatus(projectID, name string, status instances.LoadBalancerStatus, errs ...instances.LoadBalancerError) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lbs.setFault(key(projectID, name), func(lb *loadBalancer) {
+		st := status
+		lb.Status = &st
+		lb.Errors = &errs
+	})
+}
+
+// renderLoadBalancer returns the load balancer with its status
+func renderLoadBalancer(e *entry[loadBalancer]) loadBalancer {
+	lb := e.obj
+	status := instances.STATUS_READY
+	switch e.phase {
+	case phaseCreating, phaseUpdating:
+		status = instances.STATUS_PENDING
+	case phaseDeleting:
+		status = instances.STATUS_TERMINATING
+	}
+	lb.Status = &status
+	return e.withFault(lb)
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	items := []loadBalancer{}
+	for _, e := range s.lbs.list(key(r.PathValue("projectID"), ""), s.reads) {
+		items = append(items, renderLoadBalancer(e))
+	}
+	writeJSON(w, http.StatusOK, instances.ListLoadBalancersResponse{LoadBalancers: &items})
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	body := loadBalancer{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	k := key(r.PathValue("projectID"), *body.Name)
+	if _, ok := s.lbs.items[k]; ok {
+		writeError(w, http.StatusConflict, "load balancer already exists")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderLoadBalancer(s.lbs.add(k, body)))
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.lbs.get(key(r.PathValue("projectID"), r.PathValue("name")), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderLoadBalancer(e))
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	body := loadBalancer{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	name := r.PathValue("name")
+	body.Name = &name
+	k := key(r.PathValue("projectID"), name)
+	e, ok := s.lbs.items[k]
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	if !s.lbs.update(k, body) {
+		writeError(w, http.StatusConflict, "load balancer is being deleted")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderLoadBalancer(e))
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	if !s.lbs.remove(key(r.PathValue("projectID"), r.PathValue("name"))) {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
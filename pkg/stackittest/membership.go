@@ -0,0 +1,20 @@
+package stackittest
+
+import (
+	"net/http"
+
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+)
+
+// registerMembership registers the membership routes
+// service accounts of the fake server have no memberships
+func (s *Server) registerMembership() {
+	s.handle(http.MethodGet, membership.BaseURLs, "/v2/users/{email}/memberships", s.listUserMemberships)
+}
+
+func (s *Server) listUserMemberships(w http.ResponseWriter, r *http.Request) {
+	if _, ok := query(w, r, "resourceType", "resourceId", "parentResourceId"); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, membership.UserMembershipsResponse{Items: []membership.UserMembership{}})
+}
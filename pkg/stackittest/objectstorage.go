@@ -0,0 +1,73 @@
+package stackittest
+
+import (
+	"fmt"
+	"net/http"
+
+	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+)
+
+const bucketRegion = "eu01"
+
+type bucket struct {
+	Name                  string `json:"name"`
+	Region                string `json:"region"`
+	UrlPathStyle          string `json:"urlPathStyle"`
+	UrlVirtualHostedStyle string `json:"urlVirtualHostedStyle"`
+}
+
+// registerObjectStorage registers the bucket routes
+// a bucket that is being created isn't visible until it's ready, like in the object storage API
+func (s *Server) registerObjectStorage() {
+	b := objectstorage.BaseURLs
+	s.handle(http.MethodGet, b, "/v1/project/{projectID}/buckets", s.listBuckets)
+	s.handle(http.MethodGet, b, "/v1/project/{projectID}/bucket/{bucketName}", s.getBucket)
+	s.handle(http.MethodPost, b, "/v1/project/{projectID}/bucket/{bucketName}", s.createBucket)
+	s.handle(http.MethodDelete, b, "/v1/project/{projectID}/bucket/{bucketName}", s.deleteBucket)
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	projectID := r.PathValue("projectID")
+	items := []bucket{}
+	for _, e := range s.buckets.list(key(projectID, ""), s.reads) {
+		if e.phase != phaseCreating {
+			items = append(items, e.obj)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"buckets": items, "project": projectID})
+}
+
+func (s *Server) getBucket(w http.ResponseWriter, r *http.Request) {
+	projectID := r.PathValue("projectID")
+	e, ok := s.buckets.get(key(projectID, r.PathValue("bucketName")), s.reads)
+	if !ok || e.phase == phaseCreating {
+		writeError(w, http.StatusNotFound, "bucket not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"bucket": e.obj, "project": projectID})
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	projectID, name := r.PathValue("projectID"), r.PathValue("bucketName")
+	k := key(projectID, name)
+	if _, ok := s.buckets.items[k]; ok {
+		writeError(w, http.StatusConflict, "bucket already exists")
+		return
+	}
+	s.buckets.add(k, bucket{
+		Name:                  name,
+		Region:                bucketRegion,
+		UrlPathStyle:          fmt.Sprintf("https://object.storage.%s.onstackit.cloud/%s", bucketRegion, name),
+		UrlVirtualHostedStyle: fmt.Sprintf("https://%s.object.storage.%s.onstackit.cloud", name, bucketRegion),
+	})
+	writeJSON(w, http.StatusCreated, map[string]string{"bucket": name, "project": projectID})
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	projectID, name := r.PathValue("projectID"), r.PathValue("bucketName")
+	if !s.buckets.remove(key(projectID, name)) {
+		writeError(w, http.StatusNotFound, "bucket not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"bucket": name, "project": projectID})
+}
@@ -0,0 +1,144 @@
+package stackittest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/google/uuid"
+)
+
+const defaultListLimit = 50
+
+// rmListParams are the query parameters of the project list, named as in the spec
+var rmListParams = []string{"containerParentId", "containerIds", "member", "offset", "limit", "creation-time-start"}
+
+type rmProject = resourcemanagement.ProjectResponse
+
+// registerResourceManagement registers the resource manager project routes
+func (s *Server) registerResourceManagement() {
+	b := resourcemanagement.BaseURLs
+	s.handle(http.MethodGet, b, "/projects", s.listProjects)
+	s.handle(http.MethodPost, b, "/projects", s.createProject)
+	s.handle(http.MethodGet, b, "/projects/{containerID}", s.getProject)
+	s.handle(http.MethodDelete, b, "/projects/{containerID}", s.deleteProject)
+}
+
+// AddProject stores an active project and returns its container ID
+func (s *Server) AddProject(name, parentContainerID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := newProject(resourcemanagement.ProjectRequestBody{Name: name, ContainerParentID: parentContainerID})
+	s.projects.add(p.ContainerID, p).setPhase(phaseReady)
+	return p.ContainerID
+}
+
+func newProject(body resourcemanagement.ProjectRequestBody) rmProject {
+	id := uuid.New()
+	now := time.Now().UTC().Format(time.RFC3339)
+	return rmProject{
+		ContainerID:  fmt.Sprintf("project-%s", id.String()[:8]),
+		CreationTime: now,
+		Labels:       body.Labels,
+		Name:         body.Name,
+		Parent: resourcemanagement.Parent{
+			ContainerID: body.ContainerParentID,
+			ID:          uuid.New(),
+			Type:        resourcemanagement.PARENT_TYPE_ORGANIZATION,
+		},
+		ProjectID:  id,
+		UpdateTime: now,
+	}
+}
+
+// renderProject returns the project with its lifecycle state
+func renderProject(e *entry[rmProject]) rmProject {
+	p := e.obj
+	switch e.phase {
+	case phaseCreating:
+		p.LifecycleState = resourcemanagement.CREATING
+	case phaseReady:
+		p.LifecycleState = resourcemanagement.ACTIVE
+	case phaseDeleting:
+		p.LifecycleState = resourcemanagement.DELETING
+	}
+	return p
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	q, ok := query(w, r, rmListParams...)
+	if !ok {
+		return
+	}
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	items := []rmProject{}
+	for _, e := range s.projects.list("", s.reads) {
+		if parent := q.Get("containerParentId"); parent != "" && e.obj.Parent.ContainerID != parent {
+			continue
+		}
+		if ids := q["containerIds"]; len(ids) > 0 && !contains(ids, e.obj.ContainerID) {
+			continue
+		}
+		items = append(items, renderProject(e))
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	items = items[offset:]
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	writeJSON(w, http.StatusOK, resourcemanagement.AllProjectsResponse{
+		Items:  items,
+		Limit:  float32(limit),
+		Offset: float32(offset),
+	})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	body := resourcemanagement.ProjectRequestBody{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Name == "" || body.ContainerParentID == "" {
+		writeError(w, http.StatusBadRequest, "name and containerParentId are required")
+		return
+	}
+	p := newProject(body)
+	e := s.projects.add(p.ContainerID, p)
+	writeJSON(w, http.StatusCreated, renderProject(e))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.projects.get(r.PathValue("containerID"), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	p := renderProject(e)
+	writeJSON(w, http.StatusOK, resourcemanagement.ProjectResponseWithParents{
+		ContainerID:    p.ContainerID,
+		CreationTime:   p.CreationTime,
+		Labels:         p.Labels,
+		LifecycleState: p.LifecycleState,
+		Name:           p.Name,
+		Parent:         p.Parent,
+		ProjectID:      p.ProjectID,
+		UpdateTime:     p.UpdateTime,
+	})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	if !s.projects.remove(r.PathValue("containerID")) {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,75 @@
+package stackittest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	secretsmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/google/uuid"
+)
+
+type secretsInstance = instances.Instance
+
+// registerSecretsManager registers the secrets manager instance routes
+func (s *Server) registerSecretsManager() {
+	b := secretsmanager.BaseURLs
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/instances", s.listSecretsInstances)
+	s.handle(http.MethodPost, b, "/v1/projects/{projectID}/instances", s.createSecretsInstance)
+	s.handle(http.MethodGet, b, "/v1/projects/{projectID}/instances/{instanceID}", s.getSecretsInstance)
+	s.handle(http.MethodDelete, b, "/v1/projects/{projectID}/instances/{instanceID}", s.deleteSecretsInstance)
+}
+
+// renderSecretsInstance returns the instance with its state
+func renderSecretsInstance(e *entry[secretsInstance]) secretsInstance {
+	inst := e.obj
+	inst.State = string(instances.STATE_CREATING)
+	if e.phase != phaseCreating {
+		inst.State = string(instances.STATE_ACTIVE)
+		finished := inst.CreationStartDate
+		inst.CreationFinishedDate = &finished
+	}
+	return inst
+}
+
+func (s *Server) listSecretsInstances(w http.ResponseWriter, r *http.Request) {
+	items := []secretsInstance{}
+	for _, e := range s.secrets.list(key(r.PathValue("projectID"), ""), s.reads) {
+		items = append(items, renderSecretsInstance(e))
+	}
+	writeJSON(w, http.StatusOK, instances.InstanceList{Instances: items})
+}
+
+func (s *Server) createSecretsInstance(w http.ResponseWriter, r *http.Request) {
+	body := instances.InstanceCreate{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	id := uuid.NewString()
+	e := s.secrets.add(key(r.PathValue("projectID"), id), secretsInstance{
+		ApiUrl:            fmt.Sprintf("https://%s.secrets-manager.example.com", id),
+		CreationStartDate: time.Now().UTC().Format(time.RFC3339),
+		ID:                id,
+		Name:              body.Name,
+		SecretsEngine:     "kv-v2",
+	})
+	writeJSON(w, http.StatusCreated, renderSecretsInstance(e))
+}
+
+func (s *Server) getSecretsInstance(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.secrets.get(key(r.PathValue("projectID"), r.PathValue("instanceID")), s.reads)
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderSecretsInstance(e))
+}
+
+func (s *Server) deleteSecretsInstance(w http.ResponseWriter, r *http.Request) {
+	if !s.secrets.remove(key(r.PathValue("projectID"), r.PathValue("instanceID"))) {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
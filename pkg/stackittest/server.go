@@ -0,0 +1,198 @@
+// Package stackittest provides an in-process fake of the STACKIT APIs for integration tests
+// the fake server keeps resources in memory and moves them through asynchronous states,
+// so wait handlers, watchers and key flow authentication can be exercised without a STACKIT project
+package stackittest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+)
+
+const (
+	// DefaultTransitionReads is the default number of reads a resource
+	// stays in a transitional state, i.e. creating or deleting
+	DefaultTransitionReads = 2
+)
+
+// Server is a fake STACKIT API server
+// requests are routed by host, so a client using Transport
+// reaches the fake server with the real STACKIT base URLs
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	mux    *http.ServeMux
+	reads  int
+	key    *rsa.PrivateKey
+	saKeys map[string]*rsa.PublicKey
+
+	accessTokens map[string]bool // access tokens of service accounts by key of project, email and token ID
+	revoked      map[string]bool // IDs of revoked access tokens
+
+	projects    *store[rmProject]
+	skeProjects *store[skeProject]
+	clusters    *store[skeCluster]
+	buckets     *store[bucket]
+	postgres    *store[flexInstance]
+	mongodb     *store[flexInstance]
+	dsa         *store[dsaInstance]
+	secrets     *store[secretsInstance]
+	lbs         *store[loadBalancer]
+	argus       *store[argusInstance]
+}
+
+// NewServer starts a new fake server
+// the server should be closed with Close when the test is done
+func NewServer() *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	s := &Server{
+		mux:          http.NewServeMux(),
+		reads:        DefaultTransitionReads,
+		key:          key,
+		saKeys:       map[string]*rsa.PublicKey{},
+		accessTokens: map[string]bool{},
+		revoked:      map[string]bool{},
+		projects:     newStore[rmProject](),
+		skeProjects:  newStore[skeProject](),
+		clusters:     newStore[skeCluster](),
+		buckets:      newStore[bucket](),
+		postgres:     newStore[flexInstance](),
+		mongodb:      newStore[flexInstance](),
+		dsa:          newStore[dsaInstance](),
+		secrets:      newStore[secretsInstance](),
+		lbs:          newStore[loadBalancer](),
+		argus:        newStore[argusInstance](),
+	}
+	s.registerAuth()
+	s.registerResourceManagement()
+	s.registerKubernetes()
+	s.registerObjectStorage()
+	s.registerFlex()
+	s.registerDataServices()
+	s.registerSecretsManager()
+	s.registerLoadBalancer()
+	s.registerArgus()
+	s.registerMembership()
+	s.registerServiceAccounts()
+	s.Server = httptest.NewServer(s.mux)
+	return s
+}
+
+// SetTransitionReads sets the number of reads a resource stays in a transitional state
+// before it becomes ready or is removed. 0 makes every transition complete on the next read
+func (s *Server) SetTransitionReads(n int) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reads = n
+	return s
+}
+
+// Transport returns a transport that sends all requests to the fake server
+// the original host is kept, so the server can route the request to the right service
+func (s *Server) Transport() http.RoundTripper {
+	target, _ := url.Parse(s.URL)
+	return &transport{target: target, base: s.Server.Client().Transport}
+}
+
+// Client returns services authenticated with a new service account of the fake server
+func (s *Server) Client(ctx context.Context) (*services.Services, error) {
+	kf := &clients.KeyFlow{}
+	if err := kf.Init(ctx, s.KeyFlowConfig()); err != nil {
+		return nil, err
+	}
+	return services.Init(kf)
+}
+
+type transport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+// RoundTrip rewrites the request to target the fake server
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.Host = req.URL.Host
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	return t.base.RoundTrip(r)
+}
+
+// pattern returns the route pattern of path under the host and base path of b
+func pattern(method string, b baseurl.BaseURL, path string) string {
+	u, err := url.Parse(b.Get())
+	if err != nil {
+		panic(err)
+	}
+	return method + " " + u.Host + strings.TrimSuffix(u.Path, "/") + path
+}
+
+// handle registers an authenticated API route
+func (s *Server) handle(method string, b baseurl.BaseURL, path string, h http.HandlerFunc) {
+	s.mux.HandleFunc(pattern(method, b, path), func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "invalid or missing access token")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		h(w, r)
+	})
+}
+
+// writeJSON writes v as JSON response with the given status code
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response with the given status code
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"message": msg})
+}
+
+// query returns the query parameters of r and writes a bad request response
+// if a parameter isn't one of the allowed ones, so clients sending misnamed parameters fail
+func query(w http.ResponseWriter, r *http.Request, allowed ...string) (url.Values, bool) {
+	q := r.URL.Query()
+	for name := range q {
+		if !contains(allowed, name) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown query parameter %s", name))
+			return nil, false
+		}
+	}
+	return q, true
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// readJSON decodes the request body into v and writes a bad request response on failure
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
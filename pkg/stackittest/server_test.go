@@ -0,0 +1,551 @@
+package stackittest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/ptr"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	argusbackup "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/backup"
+	argusinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	lbinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	mongodbinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	postgresinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	smInstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const projectID = "5dae0612-f5b1-4615-b7ca-b18796aa7e78"
+
+func newTestClient(t *testing.T) (*Server, *services.Services) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client(context.Background())
+	require.NoError(t, err)
+	return srv, c
+}
+
+// waitFor waits for h without initial delay and with a short throttle
+func waitFor[T any](t *testing.T, h *wait.Handler[T]) T {
+	require.NoError(t, h.SetThrottle(time.Millisecond))
+	res, err := h.SetInitialDelay(0).SetTimeout(5 * time.Second).Wait(context.Background())
+	require.NoError(t, err)
+	return res
+}
+
+// waitErr waits for h like waitFor and returns the error of the wait
+func waitErr[T any](t *testing.T, h *wait.Handler[T], timeout time.Duration) error {
+	require.NoError(t, h.SetThrottle(time.Millisecond))
+	_, err := h.SetInitialDelay(0).SetTimeout(timeout).Wait(context.Background())
+	return err
+}
+
+// assertTerminal asserts err is the *wait.TerminalError want, or a timeout if want is nil
+func assertTerminal(t *testing.T, want *wait.TerminalError, err error) {
+	if want == nil {
+		assert.ErrorIs(t, err, wait.ErrTimeout)
+		return
+	}
+	te := &wait.TerminalError{}
+	if assert.ErrorAs(t, err, &te) {
+		assert.Equal(t, want, te)
+	}
+}
+
+func TestServer_KeyFlow(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	kf := &clients.KeyFlow{}
+	require.NoError(t, kf.Init(context.Background(), srv.KeyFlowConfig()))
+	require.NoError(t, kf.CheckJWKS(context.Background()))
+
+	token, err := kf.GetAccessToken()
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	again, err := kf.GetAccessToken()
+	require.NoError(t, err)
+	assert.Equal(t, token, again, "a valid token should be reused")
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	c := &http.Client{Transport: srv.Transport()}
+	for _, token := range []string{"", "Bearer invalid"} {
+		req, err := http.NewRequest(http.MethodGet, "https://ske.api.eu01.stackit.cloud/v1/projects/p/clusters", nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		res, err := c.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	}
+}
+
+func TestServer_ResourceManagement(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	res, err := c.ResourceManagement.Create(ctx, resourcemanagement.ProjectRequestBody{
+		Name:              "my-project",
+		ContainerParentID: "organization-123",
+		Members:           []resourcemanagement.ProjectMember{},
+	})
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+	assert.Equal(t, resourcemanagement.CREATING, res.JSON201.LifecycleState)
+
+	containerID := res.JSON201.ContainerID
+	project := waitFor(t, res.WaitHandler(ctx, c.ResourceManagement, containerID))
+	assert.Equal(t, resourcemanagement.ACTIVE, project.JSON200.LifecycleState)
+
+	del, err := c.ResourceManagement.Delete(ctx, containerID)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.ResourceManagement, containerID))
+}
+
+func TestServer_ResourceManagementList(t *testing.T) {
+	srv, c := newTestClient(t)
+	ctx := context.Background()
+	first := srv.AddProject("first", "organization-1")
+	second := srv.AddProject("second", "organization-2")
+
+	parent := "organization-1"
+	res, err := c.ResourceManagement.List(ctx, &resourcemanagement.ListParams{ContainerParentID: &parent})
+	require.NoError(t, validate.Response(res, err, "JSON200"))
+	require.Len(t, res.JSON200.Items, 1)
+	assert.Equal(t, first, res.JSON200.Items[0].ContainerID)
+
+	ids := []interface{}{second}
+	res, err = c.ResourceManagement.List(ctx, &resourcemanagement.ListParams{ContainerIDs: &ids})
+	require.NoError(t, validate.Response(res, err, "JSON200"))
+	require.Len(t, res.JSON200.Items, 1)
+	assert.Equal(t, second, res.JSON200.Items[0].ContainerID)
+
+	req, err := http.NewRequest(http.MethodGet, "https://resource-manager.api.stackit.cloud/v2/projects?containerParentID=organization-1", nil)
+	require.NoError(t, err)
+	raw, err := c.Client.Do(req)
+	require.NoError(t, err)
+	defer raw.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, raw.StatusCode, "misnamed query parameters must be rejected")
+}
+
+func TestServer_ResourceManagementListIter(t *testing.T) {
+	srv, c := newTestClient(t)
+	ctx := context.Background()
+	want := []string{}
+	for _, name := range []string{"first", "second", "third"} {
+		want = append(want, srv.AddProject(name, "organization-1"))
+	}
+	srv.AddProject("other", "organization-2")
+
+	parent := "organization-1"
+	limit := resourcemanagement.Limit(2)
+	got := []string{}
+	for p, err := range resourcemanagement.ListIter(ctx, c.ResourceManagement, &resourcemanagement.ListParams{ContainerParentID: &parent, Limit: &limit}) {
+		require.NoError(t, err)
+		got = append(got, p.ContainerID)
+	}
+	assert.ElementsMatch(t, want, got, "all pages of the parent's projects should be listed")
+}
+
+func TestServer_Kubernetes(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	p, err := c.Kubernetes.Project.Create(ctx, projectID)
+	require.NoError(t, validate.Response(p, err))
+	waitFor(t, p.WaitHandler(ctx, c.Kubernetes.Project, projectID))
+
+	res, err := c.Kubernetes.Cluster.CreateOrUpdate(ctx, projectID, "my-cluster", cluster.SkeServiceCreateOrUpdateClusterRequest{
+		Kubernetes: cluster.Kubernetes{Version: "1.27"},
+		Nodepools:  []cluster.Nodepool{},
+	})
+	require.NoError(t, validate.Response(res, err, "JSON200.Status.Aggregated"))
+	assert.Equal(t, cluster.STATE_CREATING, *res.JSON200.Status.Aggregated)
+
+	cl := waitFor(t, res.WaitHandler(ctx, c.Kubernetes.Cluster, projectID, "my-cluster"))
+	assert.Equal(t, cluster.STATE_HEALTHY, *cl.JSON200.Status.Aggregated)
+	assert.Equal(t, "1.27", cl.JSON200.Kubernetes.Version)
+
+	del, err := c.Kubernetes.Cluster.Delete(ctx, projectID, "my-cluster")
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.Kubernetes.Cluster, projectID, "my-cluster"))
+
+	pdel, err := c.Kubernetes.Project.Delete(ctx, projectID)
+	require.NoError(t, validate.Response(pdel, err))
+	waitFor(t, pdel.WaitHandler(ctx, c.Kubernetes.Project, projectID))
+}
+
+func TestServer_ResumeWait(t *testing.T) {
+	srv, c := newTestClient(t)
+	ctx := context.Background()
+
+	p, err := c.Kubernetes.Project.Create(ctx, projectID)
+	require.NoError(t, validate.Response(p, err))
+	waitFor(t, p.WaitHandler(ctx, c.Kubernetes.Project, projectID))
+
+	res, err := c.Kubernetes.Cluster.CreateOrUpdate(ctx, projectID, "my-cluster", cluster.SkeServiceCreateOrUpdateClusterRequest{
+		Kubernetes: cluster.Kubernetes{Version: "1.27"},
+		Nodepools:  []cluster.Nodepool{},
+	})
+	require.NoError(t, validate.Response(res, err))
+	op, err := res.WaitHandler(ctx, c.Kubernetes.Cluster, projectID, "my-cluster").Operation()
+	require.NoError(t, err)
+	b, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	// the stored operation is resumed by another process
+	stored := wait.Operation{}
+	require.NoError(t, json.Unmarshal(b, &stored))
+	c2, err := srv.Client(ctx)
+	require.NoError(t, err)
+
+	_, err = cluster.ResumeWaitHandler(ctx, c2.Kubernetes.Cluster, wait.Operation{Service: "kubernetes", Kind: "project_create"})
+	assert.ErrorIs(t, err, wait.ErrUnknownOperation)
+
+	h, err := cluster.ResumeWaitHandler(ctx, c2.Kubernetes.Cluster, stored)
+	require.NoError(t, err)
+	resumed, err := h.Operation()
+	require.NoError(t, err)
+	assert.Equal(t, stored, resumed)
+	cl, ok := waitFor(t, h).(*cluster.GetResponse)
+	require.True(t, ok)
+	assert.Equal(t, cluster.STATE_HEALTHY, *cl.JSON200.Status.Aggregated)
+
+	// identifiers of services using UUIDs are parsed
+	pid := uuid.MustParse(projectID)
+	sm, err := c.SecretsManager.Instances.Create(ctx, pid, smInstances.InstanceCreate{Name: "my-secrets"})
+	require.NoError(t, validate.Response(sm, err, "JSON201"))
+	op, err = sm.WaitHandler(ctx, c.SecretsManager.Instances, pid, uuid.MustParse(sm.JSON201.ID)).Operation()
+	require.NoError(t, err)
+	h, err = smInstances.ResumeWaitHandler(ctx, c2.SecretsManager.Instances, op)
+	require.NoError(t, err)
+	inst, ok := waitFor(t, h).(*smInstances.Instance)
+	require.True(t, ok)
+	assert.Equal(t, smInstances.STATE_ACTIVE, smInstances.State(inst.State))
+
+	op.IDs["instanceID"] = "not-a-uuid"
+	_, err = smInstances.ResumeWaitHandler(ctx, c2.SecretsManager.Instances, op)
+	assert.Error(t, err)
+}
+
+func TestServer_ObjectStorage(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	res, err := c.ObjectStorage.Bucket.Create(ctx, projectID, "my-bucket")
+	require.NoError(t, validate.Response(res, err))
+
+	get, err := c.ObjectStorage.Bucket.Get(ctx, projectID, "my-bucket")
+	require.Error(t, validate.Response(get, err))
+	assert.True(t, validate.StatusEquals(get, http.StatusNotFound), "bucket should be hidden while it's created")
+
+	b := waitFor(t, res.WaitHandler(ctx, c.ObjectStorage.Bucket, projectID, "my-bucket"))
+	assert.Equal(t, "my-bucket", b.JSON200.Bucket.Name)
+
+	del, err := c.ObjectStorage.Bucket.Delete(ctx, projectID, "my-bucket")
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.ObjectStorage.Bucket, projectID, "my-bucket"))
+}
+
+func TestServer_PostgresFlex(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	name := "my-instance"
+	res, err := c.PostgresFlex.Instance.Create(ctx, projectID, postgresinstance.InstanceCreateInstanceRequest{Name: &name})
+	require.NoError(t, validate.Response(res, err, "JSON201.ID"))
+
+	id := *res.JSON201.ID
+	inst := waitFor(t, res.WaitHandler(ctx, c.PostgresFlex.Instance, projectID, id))
+	assert.Equal(t, postgresinstance.STATUS_READY, *inst.Status)
+	assert.Equal(t, name, *inst.Name)
+
+	del, err := c.PostgresFlex.Instance.Delete(ctx, projectID, id)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.PostgresFlex.Instance, projectID, id))
+}
+
+func TestServer_PostgresFlexStrictEnums(t *testing.T) {
+	// strict enums are set for the whole process, so the test can't run in parallel
+	validate.SetStrictEnums(true)
+	t.Cleanup(func() { validate.SetStrictEnums(false) })
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	name := "my-instance"
+	res, err := c.PostgresFlex.Instance.Create(ctx, projectID, postgresinstance.InstanceCreateInstanceRequest{Name: &name})
+	require.NoError(t, validate.Response(res, err, "JSON201.ID"))
+	id := *res.JSON201.ID
+	waitFor(t, res.WaitHandler(ctx, c.PostgresFlex.Instance, projectID, id))
+
+	del, err := c.PostgresFlex.Instance.Delete(ctx, projectID, id)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.PostgresFlex.Instance, projectID, id))
+
+	// the soft-deleted instance is still returned
+	get, err := c.PostgresFlex.Instance.Get(ctx, projectID, id)
+	require.NoError(t, validate.Response(get, err, "JSON200.Item.Status"))
+	assert.Equal(t, postgresinstance.STATUS_DELETED, *get.JSON200.Item.Status)
+
+	deleted, err := postgresinstance.EnsureDeleted(ctx, c.PostgresFlex.Instance, projectID, id)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func TestServer_MongoDBFlex(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	name := "my-instance"
+	res, err := c.MongoDBFlex.Instance.Create(ctx, projectID, mongodbinstance.InstanceCreateInstanceRequest{Name: &name})
+	require.NoError(t, validate.Response(res, err, "JSON202.ID"))
+
+	id := *res.JSON202.ID
+	waitFor(t, res.WaitHandler(ctx, c.MongoDBFlex.Instance, projectID, id))
+
+	del, err := c.MongoDBFlex.Instance.Delete(ctx, projectID, id)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.MongoDBFlex.Instance, projectID, id))
+}
+
+func TestServer_FlexFailure(t *testing.T) {
+	ctx := context.Background()
+	name := "my-instance"
+
+	t.Run("postgres", func(t *testing.T) {
+		srv, c := newTestClient(t)
+		res, err := c.PostgresFlex.Instance.Create(ctx, projectID, postgresinstance.InstanceCreateInstanceRequest{Name: &name})
+		require.NoError(t, validate.Response(res, err, "JSON201.ID"))
+		require.True(t, srv.SetPostgresFlexStatus(projectID, *res.JSON201.ID, postgresinstance.STATUS_FAILED))
+
+		err = waitErr(t, res.WaitHandler(ctx, c.PostgresFlex.Instance, projectID, *res.JSON201.ID), time.Second)
+		assert.ErrorIs(t, err, wait.ErrFailed)
+		assertTerminal(t, &wait.TerminalError{State: string(postgresinstance.STATUS_FAILED)}, err)
+	})
+
+	t.Run("mongodb", func(t *testing.T) {
+		srv, c := newTestClient(t)
+		res, err := c.MongoDBFlex.Instance.Create(ctx, projectID, mongodbinstance.InstanceCreateInstanceRequest{Name: &name})
+		require.NoError(t, validate.Response(res, err, "JSON202.ID"))
+		require.True(t, srv.SetMongoDBFlexStatus(projectID, *res.JSON202.ID, mongodbinstance.STATUS_FAILED))
+
+		err = waitErr(t, res.WaitHandler(ctx, c.MongoDBFlex.Instance, projectID, *res.JSON202.ID), time.Second)
+		assert.ErrorIs(t, err, wait.ErrFailed)
+		assertTerminal(t, &wait.TerminalError{State: string(mongodbinstance.STATUS_FAILED)}, err)
+	})
+}
+
+func TestServer_ArgusRestore(t *testing.T) {
+	tests := []struct {
+		name  string
+		reads int
+	}{
+		{"restore observed updating", DefaultTransitionReads},
+		{"restore applied between two reads", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+			ctx := context.Background()
+
+			name := "my-argus"
+			res, err := c.Argus.Instances.Create(ctx, projectID, argusinstances.CreateJSONRequestBody{Name: &name, PlanID: uuid.NewString()})
+			require.NoError(t, validate.Response(res, err, "JSON202"))
+			id := res.JSON202.InstanceID
+			waitFor(t, res.WaitHandler(ctx, c.Argus.Instances, projectID, id))
+
+			srv.SetTransitionReads(tt.reads)
+			restore, err := c.Argus.Backup.RestoresCreate(ctx, projectID, id, "2023-01-01", &argusbackup.RestoresCreateParams{RestoreTarget: argusbackup.RESTORES_CREATE_PARAMS_RESTORE_TARGET_GRAFANA})
+			require.NoError(t, validate.Response(restore, err))
+
+			inst := waitFor(t, restore.WaitHandler(ctx, c.Argus.Instances, projectID, id))
+			assert.Equal(t, argusinstances.PROJECT_INSTANCE_UI_STATUS_UPDATE_SUCCEEDED, inst.Status)
+		})
+	}
+}
+
+func TestServer_DataServices(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	res, err := c.Redis.Instances.Provision(ctx, projectID, instances.InstanceProvisionRequest{InstanceName: "my-redis", PlanID: "plan"})
+	require.NoError(t, validate.Response(res, err, "JSON202"))
+
+	id := res.JSON202.InstanceID
+	inst := waitFor(t, res.WaitHandler(ctx, c.Redis.Instances, projectID, id))
+	assert.Equal(t, instances.SUCCEEDED, inst.JSON200.LastOperation.State)
+
+	list, err := c.RabbitMQ.Instances.List(ctx, projectID)
+	require.NoError(t, validate.Response(list, err, "JSON200"))
+	assert.Empty(t, list.JSON200.Instances, "instances of other services should not be listed")
+
+	upd, err := c.Redis.Instances.Update(ctx, projectID, id, instances.InstanceUpdateRequest{PlanID: "other-plan"})
+	require.NoError(t, validate.Response(upd, err))
+	inst = waitFor(t, upd.WaitHandler(ctx, c.Redis.Instances, projectID, id))
+	assert.Equal(t, instances.UPDATE, inst.JSON200.LastOperation.Type)
+	assert.Equal(t, instances.SUCCEEDED, inst.JSON200.LastOperation.State)
+	assert.Equal(t, "other-plan", inst.JSON200.PlanID)
+
+	del, err := c.Redis.Instances.Deprovision(ctx, projectID, id)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.Redis.Instances, projectID, id))
+}
+
+func TestServer_SecretsManager(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	pid := uuid.MustParse(projectID)
+	res, err := c.SecretsManager.Instances.Create(ctx, pid, smInstances.InstanceCreate{Name: "my-secrets"})
+	require.NoError(t, validate.Response(res, err, "JSON201"))
+
+	id := uuid.MustParse(res.JSON201.ID)
+	inst := waitFor(t, res.WaitHandler(ctx, c.SecretsManager.Instances, pid, id))
+	assert.Equal(t, smInstances.STATE_ACTIVE, smInstances.State(inst.State))
+
+	del, err := c.SecretsManager.Instances.Delete(ctx, pid, id)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.SecretsManager.Instances, pid, id))
+}
+
+func TestServer_LoadBalancer(t *testing.T) {
+	srv, c := newTestClient(t)
+	srv.SetTransitionReads(0)
+	ctx := context.Background()
+
+	name := "my-lb"
+	res, err := c.LoadBalancer.Instances.Create(ctx, projectID, &lbinstances.CreateParams{XRequestID: uuid.New()}, lbinstances.LoadBalancer{Name: &name})
+	require.NoError(t, validate.Response(res, err, "JSON200.Status"))
+	assert.Equal(t, lbinstances.STATUS_PENDING, *res.JSON200.Status)
+
+	lb := waitFor(t, res.WaitHandler(ctx, c.LoadBalancer.Instances, projectID, name))
+	assert.Equal(t, lbinstances.STATUS_READY, *lb.JSON200.Status)
+
+	del, err := c.LoadBalancer.Instances.Delete(ctx, projectID, name)
+	require.NoError(t, validate.Response(del, err))
+	waitFor(t, del.WaitHandler(ctx, c.LoadBalancer.Instances, projectID, name))
+}
+
+func TestServer_ClusterFailure(t *testing.T) {
+	unhealthy := cluster.STATE_UNHEALTHY
+	tests := []struct {
+		name   string
+		status cluster.ClusterStatus
+		want   *wait.TerminalError
+	}{
+		{"unhealthy", cluster.ClusterStatus{Aggregated: &unhealthy}, &wait.TerminalError{State: string(unhealthy)}},
+		{"unhealthy with error", cluster.ClusterStatus{Aggregated: &unhealthy, Error: &cluster.RuntimeError{
+			Code:    ptr.Of(cluster.SKE_QUOTA_EXCEEDED),
+			Message: ptr.Of("quota exceeded"),
+			Details: ptr.Of("not enough CPUs"),
+		}}, &wait.TerminalError{State: string(unhealthy), Code: string(cluster.SKE_QUOTA_EXCEEDED), Details: []string{"quota exceeded", "not enough CPUs"}}},
+		{"temporary auth error", cluster.ClusterStatus{Aggregated: &unhealthy, Error: &cluster.RuntimeError{Code: ptr.Of(cluster.SKE_TMP_AUTH_ERROR)}}, nil},
+		{"rate limits", cluster.ClusterStatus{Aggregated: &unhealthy, Error: &cluster.RuntimeError{Code: ptr.Of(cluster.SKE_RATE_LIMITS)}}, nil},
+		{"reconciling", cluster.ClusterStatus{Aggregated: ptr.Of(cluster.STATE_RECONCILING)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+			ctx := context.Background()
+
+			res, err := c.Kubernetes.Cluster.CreateOrUpdate(ctx, projectID, "my-cluster", cluster.SkeServiceCreateOrUpdateClusterRequest{
+				Kubernetes: cluster.Kubernetes{Version: "1.27"},
+				Nodepools:  []cluster.Nodepool{},
+			})
+			require.NoError(t, validate.Response(res, err))
+			require.True(t, srv.SetClusterStatus(projectID, "my-cluster", tt.status))
+
+			err = waitErr(t, res.WaitHandler(ctx, c.Kubernetes.Cluster, projectID, "my-cluster"), 100*time.Millisecond)
+			assertTerminal(t, tt.want, err)
+
+			// the status of a cluster being deleted doesn't fail the deletion
+			del, err := c.Kubernetes.Cluster.Delete(ctx, projectID, "my-cluster")
+			require.NoError(t, validate.Response(del, err))
+			waitFor(t, del.WaitHandler(ctx, c.Kubernetes.Cluster, projectID, "my-cluster"))
+		})
+	}
+}
+
+func TestServer_DataServicesFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		op   instances.LastOperation
+		wait func(t *testing.T, c *services.Services, id string) error
+	}{
+		{"provision", instances.LastOperation{Type: instances.CREATE, State: instances.FAILED, Description: "no capacity"}, func(t *testing.T, c *services.Services, id string) error {
+			return waitErr(t, instances.ProvisionResponse{}.WaitHandler(context.Background(), c.Redis.Instances, projectID, id), time.Second)
+		}},
+		{"update", instances.LastOperation{Type: instances.UPDATE, State: instances.FAILED, Description: "invalid plan"}, func(t *testing.T, c *services.Services, id string) error {
+			return waitErr(t, instances.UpdateResponse{}.WaitHandler(context.Background(), c.Redis.Instances, projectID, id), time.Second)
+		}},
+		{"deprovision", instances.LastOperation{Type: instances.DELETE, State: instances.FAILED, Description: "backup running"}, func(t *testing.T, c *services.Services, id string) error {
+			return waitErr(t, instances.DeprovisionResponse{}.WaitHandler(context.Background(), c.Redis.Instances, projectID, id), time.Second)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+
+			res, err := c.Redis.Instances.Provision(context.Background(), projectID, instances.InstanceProvisionRequest{InstanceName: "my-redis", PlanID: "plan"})
+			require.NoError(t, validate.Response(res, err, "JSON202"))
+			id := res.JSON202.InstanceID
+			require.True(t, srv.SetDSAOperation(dataservices.Redis, projectID, id, tt.op))
+
+			assertTerminal(t, &wait.TerminalError{
+				State:   fmt.Sprintf("%s %s", tt.op.Type, tt.op.State),
+				Details: []string{tt.op.Description},
+			}, tt.wait(t, c, id))
+		})
+	}
+}
+
+func TestServer_LoadBalancerFailure(t *testing.T) {
+	errs := []lbinstances.LoadBalancerError{{
+		Type:        ptr.Of(lbinstances.TYPE_INTERNAL),
+		Description: ptr.Of("internal error"),
+	}}
+	tests := []struct {
+		name   string
+		status lbinstances.LoadBalancerStatus
+		errs   []lbinstances.LoadBalancerError
+		want   *wait.TerminalError
+	}{
+		{"error", lbinstances.STATUS_ERROR, errs, &wait.TerminalError{State: string(lbinstances.STATUS_ERROR), Details: []string{"TYPE_INTERNAL: internal error"}}},
+		{"terminating", lbinstances.STATUS_TERMINATING, nil, &wait.TerminalError{State: string(lbinstances.STATUS_TERMINATING)}},
+		{"pending", lbinstances.STATUS_PENDING, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+			ctx := context.Background()
+
+			name := "my-lb"
+			res, err := c.LoadBalancer.Instances.Create(ctx, projectID, &lbinstances.CreateParams{XRequestID: uuid.New()}, lbinstances.LoadBalancer{Name: &name})
+			require.NoError(t, validate.Response(res, err))
+			require.True(t, srv.SetLoadBalancerStatus(projectID, name, tt.status, tt.errs...))
+
+			// errors are tolerated for a few reads, as the load balancer may recover
+			err = waitErr(t, res.WaitHandler(ctx, c.LoadBalancer.Instances, projectID, name), 100*time.Millisecond)
+			assertTerminal(t, tt.want, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package stackittest
+
+import (
+	"net/http"
+	"time"
+
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	"github.com/google/uuid"
+)
+
+// registerServiceAccounts registers the access token routes of service accounts
+// access tokens are issued by the fake server, so they authenticate requests until they're revoked
+func (s *Server) registerServiceAccounts() {
+	b := serviceaccounts.BaseURLs
+	s.handle(http.MethodPost, b, "/v2/projects/{projectID}/service-accounts/{email}/access-tokens", s.createAccessToken)
+	s.handle(http.MethodDelete, b, "/v2/projects/{projectID}/service-accounts/{email}/access-tokens/{tokenID}", s.deleteAccessToken)
+}
+
+func (s *Server) createAccessToken(w http.ResponseWriter, r *http.Request) {
+	body := serviceaccounts.CreateAccessTokenRequestBody{}
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.TtlDays < 1 {
+		writeError(w, http.StatusBadRequest, "ttlDays must be at least 1")
+		return
+	}
+	id := uuid.New()
+	ttl := time.Duration(body.TtlDays) * 24 * time.Hour
+	token, err := s.signToken(r.PathValue("email"), id.String(), ttl)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.accessTokens[key(r.PathValue("projectID"), r.PathValue("email"), id.String())] = true
+	now := time.Now().UTC()
+	writeJSON(w, http.StatusCreated, serviceaccounts.AccessTokenV2{
+		Active:     true,
+		CreatedAt:  now,
+		ID:         id,
+		Token:      token,
+		ValidUntil: now.Add(ttl),
+	})
+}
+
+func (s *Server) deleteAccessToken(w http.ResponseWriter, r *http.Request) {
+	k := key(r.PathValue("projectID"), r.PathValue("email"), r.PathValue("tokenID"))
+	if !s.accessTokens[k] {
+		writeError(w, http.StatusNotFound, "access token not found")
+		return
+	}
+	delete(s.accessTokens, k)
+	s.revoked[r.PathValue("tokenID")] = true
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,147 @@
+package stackittest
+
+import (
+	"sort"
+	"strings"
+)
+
+type phase int
+
+const (
+	phaseCreating phase = iota
+	phaseReady
+	phaseUpdating
+	phaseDeleting
+)
+
+// entry is a stored resource and its lifecycle phase
+type entry[T any] struct {
+	obj   T
+	phase phase
+	reads int
+	fault func(*T) // modifies the rendered resource, i.e. to report a failure
+}
+
+// setPhase moves the entry to a new phase
+func (e *entry[T]) setPhase(p phase) {
+	e.phase = p
+	e.reads = 0
+}
+
+// store keeps resources of one kind by key
+// keys are built from the project ID and the resource name or ID
+type store[T any] struct {
+	items map[string]*entry[T]
+}
+
+func newStore[T any]() *store[T] {
+	return &store[T]{items: map[string]*entry[T]{}}
+}
+
+// key returns the store key of a resource in a project
+func key(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// add stores obj under key in the creating phase
+func (st *store[T]) add(k string, obj T) *entry[T] {
+	e := &entry[T]{obj: obj, phase: phaseCreating}
+	st.items[k] = e
+	return e
+}
+
+// get returns the entry stored under key
+// reading an entry in a transitional phase advances it, after reads reads
+// a creating or updating entry becomes ready and a deleting entry is removed
+func (st *store[T]) get(k string, reads int) (*entry[T], bool) {
+	e, ok := st.items[k]
+	if !ok {
+		return nil, false
+	}
+	if !st.advance(k, e, reads) {
+		return nil, false
+	}
+	return e, true
+}
+
+// list returns the entries whose key starts with prefix, sorted by key
+// every listed entry is read, see get
+func (st *store[T]) list(prefix string, reads int) []*entry[T] {
+	keys := []string{}
+	for k := range st.items {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	res := []*entry[T]{}
+	for _, k := range keys {
+		if e, ok := st.get(k, reads); ok {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// update replaces the entry stored under key and moves it to the updating phase
+// it returns false if there is no such entry
+func (st *store[T]) update(k string, obj T) bool {
+	e, ok := st.items[k]
+	if !ok || e.phase == phaseDeleting {
+		return false
+	}
+	e.obj = obj
+	e.setPhase(phaseUpdating)
+	return true
+}
+
+// setFault sets the fault of the entry stored under key
+// it returns false if there is no such entry
+func (st *store[T]) setFault(k string, f func(*T)) bool {
+	e, ok := st.items[k]
+	if !ok {
+		return false
+	}
+	e.fault = f
+	return true
+}
+
+// withFault returns the rendered resource obj modified by the fault of the entry, if any
+func (e *entry[T]) withFault(obj T) T {
+	if e.fault != nil {
+		e.fault(&obj)
+	}
+	return obj
+}
+
+// remove moves the entry stored under key to the deleting phase
+// it returns false if there is no such entry
+func (st *store[T]) remove(k string) bool {
+	e, ok := st.items[k]
+	if !ok {
+		return false
+	}
+	if e.phase != phaseDeleting {
+		e.setPhase(phaseDeleting)
+	}
+	return true
+}
+
+// advance counts a read of the entry and completes its transition
+// it returns false if the entry was removed
+func (st *store[T]) advance(k string, e *entry[T], reads int) bool {
+	if e.phase == phaseReady {
+		return true
+	}
+	if e.reads < reads {
+		e.reads++
+		return true
+	}
+	if e.phase == phaseDeleting {
+		delete(st.items, k)
+		return false
+	}
+	e.setPhase(phaseReady)
+	return true
+}
@@ -0,0 +1,52 @@
+package stackittest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore_Lifecycle(t *testing.T) {
+	st := newStore[string]()
+	st.add(key("p", "a"), "a")
+
+	phases := []phase{}
+	for i := 0; i < 3; i++ {
+		e, ok := st.get(key("p", "a"), 2)
+		if !assert.True(t, ok) {
+			return
+		}
+		phases = append(phases, e.phase)
+	}
+	assert.Equal(t, []phase{phaseCreating, phaseCreating, phaseReady}, phases)
+
+	assert.True(t, st.update(key("p", "a"), "b"))
+	e, _ := st.get(key("p", "a"), 0)
+	assert.Equal(t, phaseReady, e.phase)
+	assert.Equal(t, "b", e.obj)
+
+	assert.True(t, st.remove(key("p", "a")))
+	e, ok := st.get(key("p", "a"), 1)
+	assert.True(t, ok)
+	assert.Equal(t, phaseDeleting, e.phase)
+	assert.False(t, st.update(key("p", "a"), "c"))
+
+	_, ok = st.get(key("p", "a"), 1)
+	assert.False(t, ok)
+	assert.False(t, st.remove(key("p", "a")))
+}
+
+func TestStore_list(t *testing.T) {
+	st := newStore[string]()
+	st.add(key("p", "b"), "b")
+	st.add(key("p", "a"), "a")
+	st.add(key("p2", "c"), "c")
+	st.remove(key("p", "b"))
+
+	res := []string{}
+	for _, e := range st.list(key("p", ""), 0) {
+		res = append(res, e.obj)
+	}
+	assert.Equal(t, []string{"a"}, res)
+	assert.Len(t, st.items, 2)
+}
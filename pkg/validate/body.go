@@ -0,0 +1,179 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// FieldError is a violated constraint of a request body field
+type FieldError struct {
+	Field string // path of the field, i.e. nodepools[0].name
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors are the violated constraints of a request body
+// they are returned by the Validate methods of the request bodies
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := []string{}
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e FieldErrors) Unwrap() []error {
+	res := []error{}
+	for _, fe := range e {
+		res = append(res, fe)
+	}
+	return res
+}
+
+// Validator collects the violated constraints of a request body
+type Validator struct {
+	errs FieldErrors
+}
+
+// Err returns the collected errors as FieldErrors, or nil if there are none
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Add adds err for field, nil errors are ignored
+// the fields of FieldErrors, i.e. returned by the Validate method of a nested body, are prefixed with field
+func (v *Validator) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+	var nested FieldErrors
+	if errors.As(err, &nested) {
+		for _, fe := range nested {
+			sep := "."
+			if strings.HasPrefix(fe.Field, "[") {
+				sep = ""
+			}
+			v.errs = append(v.errs, &FieldError{Field: field + sep + fe.Field, Err: fe.Err})
+		}
+		return
+	}
+	v.errs = append(v.errs, &FieldError{Field: field, Err: err})
+}
+
+// Required checks that a required field is set
+func (v *Validator) Required(field string, set bool) {
+	if !set {
+		v.Add(field, errors.New("is required"))
+	}
+}
+
+// MinLength checks that s has at least n characters
+func (v *Validator) MinLength(field, s string, n int) {
+	if utf8.RuneCountInString(s) < n {
+		v.Add(field, fmt.Errorf("must have at least %d characters", n))
+	}
+}
+
+// MaxLength checks that s has at most n characters
+func (v *Validator) MaxLength(field, s string, n int) {
+	if utf8.RuneCountInString(s) > n {
+		v.Add(field, fmt.Errorf("must have at most %d characters", n))
+	}
+}
+
+// patterns caches compiled patterns
+var patterns sync.Map
+
+// Pattern checks that s matches the regular expression
+func (v *Validator) Pattern(field, s, pattern string) {
+	r, ok := patterns.Load(pattern)
+	if !ok {
+		r, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !r.(*regexp.Regexp).MatchString(s) {
+		v.Add(field, fmt.Errorf("must match %s", pattern))
+	}
+}
+
+// OneOf checks that s is one of the values
+func (v *Validator) OneOf(field, s string, values ...string) {
+	for _, value := range values {
+		if s == value {
+			return
+		}
+	}
+	v.Add(field, fmt.Errorf("%q isn't one of %s", s, strings.Join(values, ", ")))
+}
+
+// Minimum checks that x is at least min, or greater than min if exclusive is set
+func (v *Validator) Minimum(field string, x, min float64, exclusive bool) {
+	switch {
+	case exclusive && x <= min:
+		v.Add(field, fmt.Errorf("must be greater than %s", formatFloat(min)))
+	case x < min:
+		v.Add(field, fmt.Errorf("must be at least %s", formatFloat(min)))
+	}
+}
+
+// Maximum checks that x is at most max, or less than max if exclusive is set
+func (v *Validator) Maximum(field string, x, max float64, exclusive bool) {
+	switch {
+	case exclusive && x >= max:
+		v.Add(field, fmt.Errorf("must be less than %s", formatFloat(max)))
+	case x > max:
+		v.Add(field, fmt.Errorf("must be at most %s", formatFloat(max)))
+	}
+}
+
+// MinItems checks that a list of n items has at least min items
+func (v *Validator) MinItems(field string, n, min int) {
+	if n < min {
+		v.Add(field, fmt.Errorf("must have at least %d items", min))
+	}
+}
+
+// MaxItems checks that a list of n items has at most max items
+func (v *Validator) MaxItems(field string, n, max int) {
+	if n > max {
+		v.Add(field, fmt.Errorf("must have at most %d items", max))
+	}
+}
+
+// CheckEnum returns an *EnumError if e isn't one of the values of its enum
+func CheckEnum[T Enum[T]](e T) error {
+	if e.IsValid() {
+		return nil
+	}
+	return NewEnumError[T](string(e))
+}
+
+// Index returns the path of the i-th item of field
+func Index(field string, i int) string {
+	return field + "[" + strconv.Itoa(i) + "]"
+}
+
+// Key returns the path of the value of key in field
+func Key(field, key string) string {
+	return field + "[" + strconv.Quote(key) + "]"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	v := &Validator{}
+	assert.NoError(t, v.Err())
+
+	v.Required("name", false)
+	v.MinLength("name", "", 1)
+	v.MaxLength("name", "äöü", 3)
+	v.Pattern("name", "a-1", `^[a-z0-9]+$`)
+	v.OneOf("effect", "NoRun", "NoSchedule", "NoExecute")
+	v.Minimum("size", 10, 20, false)
+	v.Minimum("size", 20, 20, true)
+	v.Maximum("size", 10, 10, false)
+	v.Maximum("size", 11, 10, false)
+	v.MinItems("pools", 0, 1)
+	v.MaxItems("pools", 2, 1)
+	v.Add("effect", CheckEnum(color("GREEN")))
+	v.Add("effect", CheckEnum(red))
+	v.Add("ignored", nil)
+
+	err := v.Err()
+	var fe FieldErrors
+	require.ErrorAs(t, err, &fe)
+	assert.Equal(t, []string{
+		"name: is required",
+		"name: must have at least 1 characters",
+		"name: must match ^[a-z0-9]+$",
+		`effect: "NoRun" isn't one of NoSchedule, NoExecute`,
+		"size: must be at least 20",
+		"size: must be greater than 20",
+		"size: must be at most 10",
+		"pools: must have at least 1 items",
+		"pools: must have at most 1 items",
+		`effect: "GREEN" isn't a valid validate.color, valid values are: RED, BLUE`,
+	}, messages(fe))
+
+	var ee *EnumError
+	assert.ErrorAs(t, err, &ee)
+}
+
+func TestValidator_Add(t *testing.T) {
+	nested := &Validator{}
+	nested.Add("name", errors.New("is invalid"))
+	items := &Validator{}
+	items.Add(Index("", 1), errors.New("is invalid"))
+
+	v := &Validator{}
+	v.Add(Index("nodepools", 0), nested.Err())
+	v.Add(Key("labels", "app"), errors.New("is invalid"))
+	v.Add("taints", items.Err())
+
+	assert.EqualError(t, v.Err(), `nodepools[0].name: is invalid; labels["app"]: is invalid; taints[1]: is invalid`)
+}
+
+func messages(errs FieldErrors) []string {
+	res := []string{}
+	for _, e := range errs {
+		res = append(res, e.Error())
+	}
+	return res
+}
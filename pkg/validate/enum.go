@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// strictEnums makes enums reject unknown values when unmarshalling JSON
+var strictEnums atomic.Bool
+
+// SetStrictEnums sets whether enums reject unknown values when unmarshalling JSON
+// by default unknown values are accepted, so responses can be read when the API adds values
+// IsValid can be used to check values either way
+// the setting applies to the whole process, i.e. to every client, and should be set once at startup
+func SetStrictEnums(strict bool) {
+	strictEnums.Store(strict)
+}
+
+// StrictEnums returns whether enums reject unknown values when unmarshalling JSON
+func StrictEnums() bool {
+	return strictEnums.Load()
+}
+
+// Enum is implemented by the enums of the services
+type Enum[T any] interface {
+	~string
+	IsValid() bool
+	Values() []T
+}
+
+// EnumError is returned when a value isn't one of the values of an enum
+type EnumError struct {
+	Enum   string
+	Value  string
+	Values []string
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("%q isn't a valid %s, valid values are: %s", e.Value, e.Enum, strings.Join(e.Values, ", "))
+}
+
+// NewEnumError returns an *EnumError for value of enum T
+func NewEnumError[T Enum[T]](value string) error {
+	var e T
+	values := []string{}
+	for _, v := range e.Values() {
+		values = append(values, string(v))
+	}
+	return &EnumError{Enum: fmt.Sprintf("%T", e), Value: value, Values: values}
+}
+
+// ParseEnum returns s as enum T, or an *EnumError if s isn't one of its values
+func ParseEnum[T Enum[T]](s string) (T, error) {
+	e := T(s)
+	if !e.IsValid() {
+		return e, NewEnumError[T](s)
+	}
+	return e, nil
+}
+
+// UnmarshalEnum unmarshals the JSON string b into v
+// unknown values are rejected with an *EnumError if StrictEnums is set
+func UnmarshalEnum[T Enum[T]](b []byte, v *T) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if StrictEnums() {
+		e, err := ParseEnum[T](s)
+		if err != nil {
+			return err
+		}
+		*v = e
+		return nil
+	}
+	*v = T(s)
+	return nil
+}
@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type color string
+
+const (
+	red  color = "RED"
+	blue color = "BLUE"
+)
+
+func (color) Values() []color {
+	return []color{red, blue}
+}
+
+func (c color) IsValid() bool {
+	return c == red || c == blue
+}
+
+func (c *color) UnmarshalJSON(b []byte) error {
+	return UnmarshalEnum(b, c)
+}
+
+func TestParseEnum(t *testing.T) {
+	c, err := ParseEnum[color]("RED")
+	require.NoError(t, err)
+	assert.Equal(t, red, c)
+
+	c, err = ParseEnum[color]("GREEN")
+	assert.Equal(t, color("GREEN"), c)
+	assert.EqualError(t, err, `"GREEN" isn't a valid validate.color, valid values are: RED, BLUE`)
+	var ee *EnumError
+	require.ErrorAs(t, err, &ee)
+	assert.Equal(t, "GREEN", ee.Value)
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	type item struct {
+		Color   color  `json:"color"`
+		Pointer *color `json:"pointer"`
+	}
+	defer SetStrictEnums(false)
+
+	tests := []struct {
+		name    string
+		strict  bool
+		input   string
+		want    item
+		wantErr bool
+	}{
+		{"known value", false, `{"color": "RED"}`, item{Color: red}, false},
+		{"unknown value", false, `{"color": "GREEN"}`, item{Color: "GREEN"}, false},
+		{"null", false, `{"color": null, "pointer": null}`, item{}, false},
+		{"strict known value", true, `{"color": "BLUE", "pointer": "RED"}`, item{Color: blue, Pointer: func() *color { c := red; return &c }()}, false},
+		{"strict unknown value", true, `{"color": "GREEN"}`, item{}, true},
+		{"strict unknown pointer", true, `{"pointer": "GREEN"}`, item{}, true},
+		{"not a string", false, `{"color": 1}`, item{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetStrictEnums(tt.strict)
+			assert.Equal(t, tt.strict, StrictEnums())
+			got := item{}
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package wait
+
+import "time"
+
+// clock provides the time used by the handler
+// it allows replacing real time in tests
+type clock interface {
+	Now() time.Time
+
+	// After returns a channel receiving the time after d and a function stopping the timer
+	After(d time.Duration) (<-chan time.Time, func())
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) (<-chan time.Time, func()) {
+	t := time.NewTimer(d)
+	return t.C, func() { t.Stop() }
+}
@@ -0,0 +1,72 @@
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrTimeout is returned when the wait timeout is reached before the wait is done
+	ErrTimeout = errors.New("Wait() has timed out")
+
+	// ErrCanceled is returned when the given context is done before the wait is done
+	ErrCanceled = errors.New("Wait() has been canceled")
+
+	// ErrFailed is matched by the error returned when the wait function failed
+	ErrFailed = errors.New("defined wait function returned an error")
+
+	// ErrTerminal is matched by the error returned when the resource reached a terminal failure state
+	ErrTerminal = errors.New("resource reached a terminal failure state")
+
+	// ErrUnknownOperation is matched by the error returned when a stored operation can't be resumed by a service's wait handlers
+	ErrUnknownOperation = errors.New("operation can't be resumed")
+)
+
+// FailedError is returned when the wait function returned an error
+// it holds the last observed state, which is empty if no state function is set
+type FailedError struct {
+	State string
+	Err   error
+}
+
+func (e *FailedError) Error() string {
+	if e.State == "" {
+		return fmt.Sprintf("%s: %s", ErrFailed, e.Err)
+	}
+	return fmt.Sprintf("%s (last observed state: %s): %s", ErrFailed, e.State, e.Err)
+}
+
+// Unwrap returns the error returned by the wait function
+func (e *FailedError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrFailed
+func (e *FailedError) Is(target error) bool {
+	return target == ErrFailed
+}
+
+// TerminalError is returned by wait functions when the resource reached a state it won't recover from
+// Code and Details hold the diagnostics provided by the server, if any
+type TerminalError struct {
+	State   string
+	Code    string
+	Details []string
+}
+
+func (e *TerminalError) Error() string {
+	msg := fmt.Sprintf("%s: %s", ErrTerminal, e.State)
+	if e.Code != "" {
+		msg += fmt.Sprintf(" (%s)", e.Code)
+	}
+	if len(e.Details) > 0 {
+		msg += ": " + strings.Join(e.Details, "; ")
+	}
+	return msg
+}
+
+// Is reports whether target is ErrTerminal
+func (e *TerminalError) Is(target error) bool {
+	return target == ErrTerminal
+}
@@ -0,0 +1,273 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Group waits for multiple handlers concurrently
+// all handlers share the group's timeout and context
+type Group struct {
+	timeout    time.Duration
+	failFast   bool
+	onProgress func(p GroupProgress)
+
+	hookMu  sync.Mutex // serializes the progress hook calls, it's locked before mu
+	mu      sync.Mutex
+	start   time.Time
+	members []*member
+	clock   clock
+}
+
+// member is a handler added to the group
+type member struct {
+	MemberProgress
+	run func(ctx context.Context, timeout time.Duration) error
+}
+
+// Result holds the outcome of a handler added to a group
+// it's set once the group's Wait returns
+type Result[T any] struct {
+	Name  string
+	Value T
+	Err   error
+}
+
+// NewGroup creates a new wait group
+// by default all handlers are waited for, see SetFailFast
+func NewGroup() *Group {
+	return &Group{
+		timeout: DefaultTimeout,
+	}
+}
+
+// SetTimeout sets the duration for the group timeout
+// a handler's own timeout is kept if it's shorter, 0 means only the handlers' timeouts apply
+func (g *Group) SetTimeout(d time.Duration) *Group {
+	g.timeout = d
+	return g
+}
+
+// SetFailFast sets whether the group stops waiting on the first failure
+// if true, the remaining handlers are canceled and the first error is returned
+// otherwise all handlers are waited for and their errors are returned as *GroupError
+func (g *Group) SetFailFast(failFast bool) *Group {
+	g.failFast = failFast
+	return g
+}
+
+// OnProgress sets a hook that is called whenever one of the handlers
+// polled its function, changed its state or finished
+// calls are serialized
+func (g *Group) OnProgress(fn func(p GroupProgress)) *Group {
+	g.onProgress = fn
+	return g
+}
+
+// Add adds a handler to the group under the given name
+// the handler's hooks are kept and called before the group's progress hook
+// the returned result is set once the group's Wait returns
+func Add[T any](g *Group, name string, h *Handler[T]) *Result[T] {
+	r := &Result[T]{Name: name}
+	m := &member{MemberProgress: MemberProgress{Name: name}}
+
+	c := *h
+	onProgress, onStateChange := h.onProgress, h.onStateChange
+	c.onProgress = func(attempt int, res T, elapsed time.Duration) {
+		if onProgress != nil {
+			onProgress(attempt, res, elapsed)
+		}
+		g.update(m, func() { m.Attempts = attempt })
+	}
+	c.onStateChange = func(from, to string, res T) {
+		if onStateChange != nil {
+			onStateChange(from, to, res)
+		}
+		g.update(m, func() { m.State = to })
+	}
+	m.run = func(ctx context.Context, timeout time.Duration) error {
+		if timeout > 0 && c.timeout > timeout {
+			c.timeout = timeout
+		}
+		if c.clock == nil {
+			c.clock = g.clock
+		}
+		r.Value, r.Err = c.Wait(ctx)
+		return r.Err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.members = append(g.members, m)
+	return r
+}
+
+// Wait waits for all handlers of the group
+// in fail fast mode, the first error is returned as *MemberError
+// otherwise a *GroupError holding the errors of all failed handlers is returned
+func (g *Group) Wait(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g.mu.Lock()
+	g.start = g.getClock().Now()
+	members := append([]*member{}, g.members...)
+	g.mu.Unlock()
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	for _, m := range members {
+		wg.Add(1)
+		go func(m *member) {
+			defer wg.Done()
+			err := m.run(ctx, g.timeout)
+			g.update(m, func() {
+				m.Done = true
+				m.Err = err
+			})
+			if err != nil && g.failFast {
+				once.Do(func() {
+					first = &MemberError{Name: m.Name, Err: err}
+					cancel()
+				})
+			}
+		}(m)
+	}
+	wg.Wait()
+
+	if g.failFast {
+		return first
+	}
+	errs := []*MemberError{}
+	for _, m := range members {
+		if m.Err != nil {
+			errs = append(errs, &MemberError{Name: m.Name, Err: m.Err})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &GroupError{Errors: errs}
+}
+
+// update applies fn to a member and reports the group progress
+// the hook is called without holding mu, so it may call the group's methods
+func (g *Group) update(m *member, fn func()) {
+	g.hookMu.Lock()
+	defer g.hookMu.Unlock()
+
+	g.mu.Lock()
+	fn()
+	if g.onProgress == nil {
+		g.mu.Unlock()
+		return
+	}
+	p := GroupProgress{
+		Members: make([]MemberProgress, 0, len(g.members)),
+		Elapsed: g.getClock().Now().Sub(g.start),
+	}
+	for _, m := range g.members {
+		p.Members = append(p.Members, m.MemberProgress)
+	}
+	g.mu.Unlock()
+
+	g.onProgress(p)
+}
+
+// getClock returns the group's clock or the real clock if none is set
+func (g *Group) getClock() clock {
+	if g.clock == nil {
+		return realClock{}
+	}
+	return g.clock
+}
+
+// GroupProgress is the combined progress of all handlers in a group
+type GroupProgress struct {
+	Members []MemberProgress
+	Elapsed time.Duration
+}
+
+// MemberProgress is the progress of a single handler in a group
+type MemberProgress struct {
+	Name     string
+	State    string
+	Attempts int
+	Done     bool
+	Err      error
+}
+
+// Completed returns the number of handlers that finished
+func (p GroupProgress) Completed() int {
+	n := 0
+	for _, m := range p.Members {
+		if m.Done {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns a one line summary of the progress, i.e.
+// 1/2 done after 1m0s: cluster (Reconciling), postgres (Ready)
+func (p GroupProgress) String() string {
+	s := make([]string, 0, len(p.Members))
+	for _, m := range p.Members {
+		status := m.State
+		switch {
+		case m.Err != nil:
+			status = "failed"
+		case m.Done && status == "":
+			status = "done"
+		case status == "":
+			status = "pending"
+		}
+		s = append(s, fmt.Sprintf("%s (%s)", m.Name, status))
+	}
+	return fmt.Sprintf("%d/%d done after %s: %s", p.Completed(), len(p.Members), p.Elapsed.Round(time.Second), strings.Join(s, ", "))
+}
+
+// MemberError is the error of a single handler in a group
+type MemberError struct {
+	Name string
+	Err  error
+}
+
+func (e *MemberError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Err)
+}
+
+// Unwrap returns the handler's error
+func (e *MemberError) Unwrap() error {
+	return e.Err
+}
+
+// GroupError holds the errors of all failed handlers in a group
+type GroupError struct {
+	Errors []*MemberError
+}
+
+func (e *GroupError) Error() string {
+	s := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		s = append(s, err.Error())
+	}
+	return fmt.Sprintf("%d wait(s) failed: %s", len(e.Errors), strings.Join(s, "; "))
+}
+
+// Is reports whether any of the handlers' errors matches target
+func (e *GroupError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestHandler returns a handler that is done after the given number of attempts
+func newTestHandler[T any](attempts int, res T, err error) *Handler[T] {
+	n := 0
+	h := NewHandler(func() (T, bool, error) {
+		n++
+		if n < attempts {
+			return res, false, nil
+		}
+		return res, true, err
+	})
+	h.throttle = time.Millisecond
+	return h
+}
+
+func TestGroup_Wait(t *testing.T) {
+	g := NewGroup()
+	a := Add(g, "a", newTestHandler(3, 1, nil))
+	b := Add(g, "b", newTestHandler(1, "b", nil))
+
+	err := g.Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, a.Value)
+	assert.NoError(t, a.Err)
+	assert.Equal(t, "b", b.Value)
+	assert.NoError(t, b.Err)
+}
+
+func TestGroup_Wait_CollectAll(t *testing.T) {
+	failure := errors.New("something happened")
+
+	g := NewGroup()
+	a := Add(g, "a", newTestHandler(2, 0, failure))
+	b := Add(g, "b", newTestHandler(5, 0, nil))
+	c := Add(g, "c", newTestHandler(1, 0, failure))
+
+	err := g.Wait(context.Background())
+	var ge *GroupError
+	if !assert.ErrorAs(t, err, &ge) {
+		return
+	}
+	assert.Len(t, ge.Errors, 2)
+	assert.Equal(t, "a", ge.Errors[0].Name)
+	assert.Equal(t, "c", ge.Errors[1].Name)
+	assert.ErrorIs(t, err, ErrFailed)
+	assert.ErrorIs(t, err, failure)
+	assert.Error(t, a.Err)
+	assert.NoError(t, b.Err)
+	assert.Error(t, c.Err)
+}
+
+func TestGroup_Wait_FailFast(t *testing.T) {
+	failure := errors.New("something happened")
+
+	g := NewGroup().SetFailFast(true)
+	Add(g, "failing", newTestHandler(1, 0, failure))
+	pending := Add(g, "pending", newTestHandler(1000000, 0, nil))
+
+	err := g.Wait(context.Background())
+	var me *MemberError
+	if !assert.ErrorAs(t, err, &me) {
+		return
+	}
+	assert.Equal(t, "failing", me.Name)
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorIs(t, pending.Err, ErrCanceled)
+}
+
+func TestGroup_Wait_Timeout(t *testing.T) {
+	g := NewGroup().SetTimeout(20 * time.Millisecond)
+	pending := Add(g, "pending", newTestHandler(1000000, 0, nil))
+	done := Add(g, "done", newTestHandler(1, 0, nil))
+
+	err := g.Wait(context.Background())
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.ErrorIs(t, pending.Err, ErrTimeout)
+	assert.NoError(t, done.Err)
+}
+
+func TestGroup_OnProgress(t *testing.T) {
+	states := []string{"PENDING", "PENDING", "READY"}
+	n := 0
+	h := NewHandler(func() (string, bool, error) {
+		s := states[n]
+		n++
+		return s, s == "READY", nil
+	}).SetStateFunc(func(res string) string { return res })
+	h.throttle = time.Millisecond
+
+	transitions := []string{}
+	h.OnStateChange(func(from, to string, res string) {
+		transitions = append(transitions, to)
+	})
+
+	reports := []GroupProgress{}
+	g := NewGroup().OnProgress(func(p GroupProgress) {
+		reports = append(reports, p)
+	})
+	Add(g, "instance", h)
+	Add(g, "bucket", newTestHandler(1, 0, nil))
+
+	assert.NoError(t, g.Wait(context.Background()))
+	assert.Equal(t, []string{"PENDING", "READY"}, transitions)
+
+	last := reports[len(reports)-1]
+	assert.Equal(t, 2, last.Completed())
+	assert.Equal(t, "instance", last.Members[0].Name)
+	assert.Equal(t, "READY", last.Members[0].State)
+	assert.Equal(t, 3, last.Members[0].Attempts)
+	assert.True(t, strings.HasPrefix(last.String(), "2/2 done after"))
+	assert.True(t, strings.HasSuffix(last.String(), "instance (READY), bucket (done)"))
+}
+
+func TestGroup_OnProgress_Add(t *testing.T) {
+	g := NewGroup()
+	added := false
+	g.OnProgress(func(p GroupProgress) {
+		// the hook may use the group, i.e. to add a handler
+		if !added {
+			added = true
+			Add(g, "late", newTestHandler(1, 0, nil))
+		}
+	})
+	Add(g, "a", newTestHandler(3, 0, nil))
+	Add(g, "b", newTestHandler(2, 0, nil))
+
+	done := make(chan error)
+	go func() { done <- g.Wait(context.Background()) }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the group is deadlocked by the progress hook")
+	}
+	assert.True(t, added)
+}
@@ -0,0 +1,151 @@
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Operation describes a pending operation waited for by a handler
+// it can be stored, i.e. as JSON, and used to resume the wait in another process
+type Operation struct {
+	// Service is the name of the service, i.e. kubernetes
+	Service string `json:"service"`
+
+	// Kind is the kind of operation, i.e. cluster_create
+	Kind string `json:"kind"`
+
+	// IDs are the identifiers passed to the wait handler, i.e. projectID and clusterName
+	IDs map[string]string `json:"ids"`
+
+	// DesiredState is the state the resource is expected to reach
+	DesiredState string `json:"desiredState,omitempty"`
+
+	// StartedAt is the time the operation was described
+	StartedAt time.Time `json:"startedAt"`
+
+	// Timeout is the wait timeout counting from StartedAt
+	Timeout time.Duration `json:"timeout"`
+}
+
+// Remaining returns the remaining wait timeout at a given time
+func (o Operation) Remaining(now time.Time) time.Duration {
+	if d := o.Timeout - now.Sub(o.StartedAt); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// UUIDs parses the identifiers with the given keys as UUIDs
+func (o Operation) UUIDs(keys ...string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(keys))
+	for i, k := range keys {
+		id, err := uuid.Parse(o.IDs[k])
+		if err != nil {
+			return nil, fmt.Errorf("operation %s '%s': %w", k, o.IDs[k], err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// Describe describes the operation waited for by the handler
+// the start time of the operation is set to the current time
+func (w *Handler[T]) Describe(service, kind, desiredState string, ids map[string]string) *Handler[T] {
+	w.operation = &Operation{
+		Service:      service,
+		Kind:         kind,
+		IDs:          ids,
+		DesiredState: desiredState,
+		StartedAt:    w.getClock().Now(),
+	}
+	return w
+}
+
+// Operation returns the operation waited for by the handler
+// the timeout is the handler's timeout, or the timeout of the resumed operation
+// an error is returned if the handler doesn't describe its operation
+func (w *Handler[T]) Operation() (Operation, error) {
+	if w.operation == nil {
+		return Operation{}, errors.New("wait handler doesn't describe its operation")
+	}
+	op := *w.operation
+	op.IDs = make(map[string]string, len(w.operation.IDs))
+	for k, v := range w.operation.IDs {
+		op.IDs[k] = v
+	}
+	if op.Timeout == 0 {
+		op.Timeout = w.timeout
+	}
+	return op, nil
+}
+
+// Resume continues a stored operation
+// the handler must be created by the same wait handler with the same IDs as the operation
+// the wait timeout is set to the operation's remaining timeout and the initial delay is
+// shortened by the time that passed since the operation started
+func (w *Handler[T]) Resume(op Operation) (*Handler[T], error) {
+	if w.operation == nil {
+		return w, errors.New("wait handler doesn't describe its operation")
+	}
+	if op.Service != w.operation.Service || op.Kind != w.operation.Kind {
+		return w, fmt.Errorf("operation %s %s can't be resumed by a %s %s wait handler", op.Service, op.Kind, w.operation.Service, w.operation.Kind)
+	}
+	for k, v := range w.operation.IDs {
+		if op.IDs[k] != v {
+			return w, fmt.Errorf("operation %s '%s' doesn't match wait handler %s '%s'", k, op.IDs[k], k, v)
+		}
+	}
+
+	now := w.getClock().Now()
+	elapsed := now.Sub(op.StartedAt)
+	w.operation.StartedAt = op.StartedAt
+	w.operation.Timeout = op.Timeout
+	w.timeout = op.Remaining(now)
+	if w.initialDelay > elapsed {
+		w.initialDelay -= elapsed
+	} else {
+		w.initialDelay = 0
+	}
+	return w, nil
+}
+
+// Any returns a handler polling the same function with an untyped result
+// settings, hooks and the described operation are kept
+// it's used to resume stored operations of different kinds with a single function
+func Any[T any](w *Handler[T]) *Handler[interface{}] {
+	h := &Handler[interface{}]{
+		fn: func() (interface{}, bool, error) {
+			return w.fn()
+		},
+		throttle:     w.throttle,
+		timeout:      w.timeout,
+		initialDelay: w.initialDelay,
+		multiplier:   w.multiplier,
+		maxInterval:  w.maxInterval,
+		transient:    w.transient,
+		clock:        w.clock,
+	}
+	if w.onProgress != nil {
+		h.onProgress = func(attempt int, res interface{}, elapsed time.Duration) {
+			w.onProgress(attempt, res.(T), elapsed)
+		}
+	}
+	if w.stateFn != nil {
+		h.stateFn = func(res interface{}) string {
+			return w.stateFn(res.(T))
+		}
+	}
+	if w.onStateChange != nil {
+		h.onStateChange = func(from, to string, res interface{}) {
+			w.onStateChange(from, to, res.(T))
+		}
+	}
+	if w.operation != nil {
+		op := *w.operation
+		h.operation = &op
+	}
+	return h
+}
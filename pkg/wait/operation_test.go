@@ -0,0 +1,144 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDescribedHandler(clk clock, ids map[string]string) *Handler[int] {
+	h := NewHandler(func() (int, bool, error) {
+		return 1, false, nil
+	})
+	h.clock = clk
+	return h.SetTimeout(20*time.Minute).SetInitialDelay(time.Minute).
+		Describe("kubernetes", "cluster_create", "STATE_HEALTHY", ids)
+}
+
+func TestHandler_Operation(t *testing.T) {
+	clk := newFakeClock()
+
+	_, err := NewHandler(func() (int, bool, error) { return 1, true, nil }).Operation()
+	assert.Error(t, err)
+
+	h := newDescribedHandler(clk, map[string]string{"projectID": "abc", "clusterName": "test"})
+	op, err := h.Operation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, Operation{
+		Service:      "kubernetes",
+		Kind:         "cluster_create",
+		IDs:          map[string]string{"projectID": "abc", "clusterName": "test"},
+		DesiredState: "STATE_HEALTHY",
+		StartedAt:    clk.now,
+		Timeout:      20 * time.Minute,
+	}, op)
+
+	b, err := json.Marshal(op)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got := Operation{}
+	assert.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, op, got)
+}
+
+func TestHandler_Resume(t *testing.T) {
+	ids := map[string]string{"projectID": "abc", "clusterName": "test"}
+	clk := newFakeClock()
+	op, _ := newDescribedHandler(clk, ids).Operation()
+
+	tests := []struct {
+		name    string
+		op      func(op Operation) Operation
+		wantErr bool
+	}{
+		{"ok", func(op Operation) Operation { return op }, false},
+		{"other kind", func(op Operation) Operation { op.Kind = "cluster_delete"; return op }, true},
+		{"other service", func(op Operation) Operation { op.Service = "argus"; return op }, true},
+		{"other ids", func(op Operation) Operation {
+			op.IDs = map[string]string{"projectID": "abc", "clusterName": "other"}
+			return op
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newDescribedHandler(clk, ids).Resume(tt.op(op))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handler.Resume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandler_Resume_Wait(t *testing.T) {
+	ids := map[string]string{"projectID": "abc", "clusterName": "test"}
+	clk := newFakeClock()
+	op, _ := newDescribedHandler(clk, ids).Operation()
+
+	// the process restarts after 15 seconds
+	clk.now = clk.now.Add(15 * time.Second)
+	h, err := newDescribedHandler(clk, ids).Resume(op)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resumed, _ := h.Operation()
+	assert.Equal(t, op, resumed)
+
+	_, err = h.Wait(context.Background())
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, 45*time.Second, clk.sleeps[0])
+	assert.Equal(t, op.StartedAt.Add(op.Timeout), clk.now)
+
+	// the process restarts after the timeout
+	clk = newFakeClock()
+	clk.now = op.StartedAt.Add(time.Hour)
+	attempts := 0
+	h = NewHandler(func() (int, bool, error) {
+		attempts++
+		return 1, true, nil
+	}).Describe("kubernetes", "cluster_create", "STATE_HEALTHY", ids)
+	h.clock = clk
+	if h, err = h.Resume(op); !assert.NoError(t, err) {
+		return
+	}
+	res, err := h.Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestAny(t *testing.T) {
+	ids := map[string]string{"projectID": "abc", "clusterName": "test"}
+	clk := newFakeClock()
+	op, _ := newDescribedHandler(clk, ids).Operation()
+
+	attempts := 0
+	states := []string{}
+	typed := NewHandler(func() (int, bool, error) {
+		attempts++
+		return attempts, attempts == 2, nil
+	}).SetStateFunc(func(res int) string {
+		return fmt.Sprintf("attempt %d", res)
+	}).OnStateChange(func(from, to string, res int) {
+		states = append(states, to)
+	}).Describe("kubernetes", "cluster_create", "STATE_HEALTHY", ids)
+	typed.clock = clk
+
+	h, err := Any(typed).Resume(op)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resumed, _ := h.Operation()
+	assert.Equal(t, op, resumed)
+
+	res, err := h.Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, res)
+	assert.Equal(t, []string{"attempt 1", "attempt 2"}, states)
+}
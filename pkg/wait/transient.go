@@ -0,0 +1,124 @@
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/pkg/errors"
+)
+
+// DefaultTransientBudget is the default number of consecutive transient errors tolerated by a handler
+const DefaultTransientBudget = 60
+
+var (
+	// DefaultTransientStatusCodes are the HTTP status codes considered transient by default
+	DefaultTransientStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+
+	// DefaultTransientMessages are the error messages considered transient by default
+	DefaultTransientMessages = []string{
+		"Client.Timeout exceeded while awaiting headers",
+		"read: connection reset",
+		"Gateway Timeout",
+	}
+)
+
+// RequestError is an error returned by a request made while polling a resource
+// only request errors are classified as transient, any other error stops the wait
+type RequestError struct {
+	StatusCode int
+	Err        error
+}
+
+// NewRequestError returns a RequestError holding the status code of res
+// or nil if err is nil
+func NewRequestError(res validate.ResponseInterface, err error) error {
+	if err == nil {
+		return nil
+	}
+	e := &RequestError{Err: err}
+	if res != nil && !(reflect.ValueOf(res).Kind() == reflect.Ptr && reflect.ValueOf(res).IsNil()) {
+		e.StatusCode = res.StatusCode()
+	}
+	return e
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the request error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// TransientClassifier decides which request errors are transient
+// transient errors don't stop the wait until the budget of consecutive transient errors is exhausted
+type TransientClassifier struct {
+	// StatusCodes are the transient HTTP status codes
+	StatusCodes []int
+
+	// Messages are sub strings of transient error messages
+	Messages []string
+
+	// Budget is the number of consecutive transient errors tolerated, 0 means unlimited
+	Budget int
+}
+
+// NewTransientClassifier returns a classifier with the default status codes, messages and budget
+func NewTransientClassifier() *TransientClassifier {
+	return &TransientClassifier{
+		StatusCodes: append([]int{}, DefaultTransientStatusCodes...),
+		Messages:    append([]string{}, DefaultTransientMessages...),
+		Budget:      DefaultTransientBudget,
+	}
+}
+
+// WithStatusCodes returns a copy of the classifier with additional transient status codes
+func (c TransientClassifier) WithStatusCodes(codes ...int) *TransientClassifier {
+	c.StatusCodes = append(append([]int{}, c.StatusCodes...), codes...)
+	return &c
+}
+
+// WithMessages returns a copy of the classifier with additional transient error messages
+func (c TransientClassifier) WithMessages(msgs ...string) *TransientClassifier {
+	c.Messages = append(append([]string{}, c.Messages...), msgs...)
+	return &c
+}
+
+// WithBudget returns a copy of the classifier with the given budget
+func (c TransientClassifier) WithBudget(budget int) *TransientClassifier {
+	c.Budget = budget
+	return &c
+}
+
+// IsTransient returns true if err is a RequestError with a transient status code or message
+func (c *TransientClassifier) IsTransient(err error) bool {
+	var re *RequestError
+	if c == nil || !errors.As(err, &re) {
+		return false
+	}
+	for _, code := range c.StatusCodes {
+		if re.StatusCode == code {
+			return true
+		}
+	}
+	return validate.ErrorIsOneOf(re.Err, c.Messages...)
+}
+
+// exhausted returns true if the given number of consecutive transient errors exceeds the budget
+func (c *TransientClassifier) exhausted(consecutive int) bool {
+	return c.Budget > 0 && consecutive > c.Budget
+}
+
+// budgetError is returned when the transient error budget is exhausted
+func budgetError(budget int, err error) error {
+	return errors.Wrap(err, fmt.Sprintf("giving up after %d consecutive transient errors", budget))
+}
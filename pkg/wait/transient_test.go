@@ -0,0 +1,87 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type response struct {
+	code int
+}
+
+func (r *response) StatusCode() int {
+	return r.code
+}
+
+func TestTransientClassifier_IsTransient(t *testing.T) {
+	var nilResponse *response
+	tests := []struct {
+		name string
+		c    *TransientClassifier
+		err  error
+		want bool
+	}{
+		{"nil classifier", nil, NewRequestError(&response{http.StatusBadGateway}, errors.New("bad gateway")), false},
+		{"not a request error", NewTransientClassifier(), errors.New("received status FAILED from server"), false},
+		{"transient status", NewTransientClassifier(), NewRequestError(&response{http.StatusBadGateway}, errors.New("bad gateway")), true},
+		{"non transient status", NewTransientClassifier(), NewRequestError(&response{http.StatusForbidden}, errors.New("forbidden")), false},
+		{"additional status", NewTransientClassifier().WithStatusCodes(http.StatusForbidden), NewRequestError(&response{http.StatusForbidden}, errors.New("forbidden")), true},
+		{"transient message", NewTransientClassifier(), NewRequestError(nilResponse, errors.New("Client.Timeout exceeded while awaiting headers")), true},
+		{"additional message", NewTransientClassifier().WithMessages("no assigned namespace"), NewRequestError(nil, errors.New("project has no assigned namespace")), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.IsTransient(tt.err); got != tt.want {
+				t.Errorf("TransientClassifier.IsTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransientClassifier_With(t *testing.T) {
+	c := NewTransientClassifier()
+	_ = c.WithStatusCodes(http.StatusForbidden).WithBudget(1)
+	if len(c.StatusCodes) != len(DefaultTransientStatusCodes) || c.Budget != DefaultTransientBudget {
+		t.Errorf("TransientClassifier was modified by With* functions: %+v", c)
+	}
+}
+
+func TestHandler_Wait_Transient(t *testing.T) {
+	transient := NewRequestError(&response{http.StatusInternalServerError}, errors.New("internal server error"))
+	tests := []struct {
+		name      string
+		responses []error
+		budget    int
+		wantPolls int
+		wantErr   bool
+	}{
+		{"recovers", []error{transient, transient, nil}, 5, 3, false},
+		{"budget resets on success", []error{transient, transient, nil, transient, transient, nil}, 2, 6, false},
+		{"budget exhausted", []error{transient, transient, transient, nil}, 2, 3, true},
+		{"fatal", []error{errors.New("received status FAILED from server"), nil}, 5, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			w := NewHandler(func() (int, bool, error) {
+				err := tt.responses[polls]
+				polls++
+				return polls, polls == len(tt.responses), err
+			}).SetTransientClassifier(NewTransientClassifier().WithBudget(tt.budget))
+			w.clock = newFakeClock()
+
+			_, err := w.Wait(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handler.Wait() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrFailed) {
+				t.Errorf("Handler.Wait() error = %v, want %v", err, ErrFailed)
+			}
+			if polls != tt.wantPolls {
+				t.Errorf("Handler.Wait() polled %d times, want %d", polls, tt.wantPolls)
+			}
+		})
+	}
+}
@@ -2,30 +2,55 @@ package wait
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
-type WaitFn func() (res interface{}, done bool, err error)
+const (
+	DefaultThrottle = 5 * time.Second
+	DefaultTimeout  = 30 * time.Minute
+)
 
-type Handler struct {
-	fn       WaitFn
-	throttle time.Duration
-	timeout  time.Duration
+// Fn is the function polled by the handler
+// it returns the last observed result, if the wait is done and an error that stops the wait
+type Fn[T any] func() (res T, done bool, err error)
+
+// Handler polls a function until it's done, fails or the timeout is reached
+type Handler[T any] struct {
+	fn           Fn[T]
+	throttle     time.Duration
+	timeout      time.Duration
+	initialDelay time.Duration
+
+	// exponential backoff
+	multiplier  float64
+	maxInterval time.Duration
+
+	// hooks
+	onProgress    func(attempt int, res T, elapsed time.Duration)
+	stateFn       func(res T) string
+	onStateChange func(from, to string, res T)
+
+	transient *TransientClassifier
+	operation *Operation
+	clock     clock
 }
 
-// New creates a new Wait instance
-func New(f WaitFn) *Handler {
-	return &Handler{
-		fn:       f,
-		throttle: 5 * time.Second,
-		timeout:  30 * time.Minute,
+// NewHandler creates a new typed wait handler
+func NewHandler[T any](f Fn[T]) *Handler[T] {
+	return &Handler[T]{
+		fn:        f,
+		throttle:  DefaultThrottle,
+		timeout:   DefaultTimeout,
+		transient: NewTransientClassifier(),
 	}
 }
 
 // SetThrottle sets the duration between func triggering
-func (w *Handler) SetThrottle(d time.Duration) error {
+// when backoff is set, this is the initial interval
+func (w *Handler[T]) SetThrottle(d time.Duration) error {
 	if d == 0 {
 		return errors.New("Throttle can't be 0")
 	}
@@ -34,59 +59,225 @@ func (w *Handler) SetThrottle(d time.Duration) error {
 }
 
 // SetTimeout sets the duration for wait timeout
-func (w *Handler) SetTimeout(d time.Duration) *Handler {
+func (w *Handler[T]) SetTimeout(d time.Duration) *Handler[T] {
 	w.timeout = d
 	return w
 }
 
-// Wait starts the wait until there's an error or wait is done
-func (w *Handler) Wait() (res interface{}, err error) {
-	var done bool
-	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
-	defer cancel()
-	for {
-		res, done, err = w.fn()
-		if err != nil || done {
-			return
-		}
+// SetInitialDelay sets the duration to wait before the first func triggering
+// the delay is part of the wait timeout
+func (w *Handler[T]) SetInitialDelay(d time.Duration) *Handler[T] {
+	w.initialDelay = d
+	return w
+}
 
-		// context timeout was chosen in order to support throttle = 0
-		tick, cancelTick := context.WithTimeout(context.Background(), w.throttle)
-		defer cancelTick()
+// SetBackoff sets an exponential backoff
+// the interval between func triggering is multiplied by multiplier after every attempt up to maxInterval
+// a multiplier <= 1 disables the backoff, a maxInterval of 0 means the interval isn't capped
+func (w *Handler[T]) SetBackoff(multiplier float64, maxInterval time.Duration) *Handler[T] {
+	w.multiplier = multiplier
+	w.maxInterval = maxInterval
+	return w
+}
 
-		select {
-		case <-tick.Done():
-			// continue
-		case <-ctx.Done():
-			return res, errors.New("Wait() has timed out")
-		}
-	}
+// OnProgress sets a hook that is called after every func triggering
+// with the attempt number (starting at 1), the observed result and the elapsed time
+func (w *Handler[T]) OnProgress(fn func(attempt int, res T, elapsed time.Duration)) *Handler[T] {
+	w.onProgress = fn
+	return w
 }
 
-// WaitWithContext starts the wait until there's an error or wait is done
-func (w Handler) WaitWithContext(ctx context.Context) (res interface{}, err error) {
+// SetStateFunc sets the function returning the state of an observed result, i.e. a cluster status
+// an empty state is considered unknown and is ignored
+func (w *Handler[T]) SetStateFunc(fn func(res T) string) *Handler[T] {
+	w.stateFn = fn
+	return w
+}
+
+// OnStateChange sets a hook that is called when the observed state changes
+// the first observed state is reported with an empty from state
+// requires a state function, which is set by the service wait handlers
+func (w *Handler[T]) OnStateChange(fn func(from, to string, res T)) *Handler[T] {
+	w.onStateChange = fn
+	return w
+}
+
+// SetTransientClassifier sets the classifier deciding which request errors are transient
+// if nil, every error stops the wait
+func (w *Handler[T]) SetTransientClassifier(c *TransientClassifier) *Handler[T] {
+	w.transient = c
+	return w
+}
+
+// GetTransientClassifier returns the classifier deciding which request errors are transient
+// it can be used to tune the classifier of a service wait handler, i.e.
+// h.SetTransientClassifier(h.GetTransientClassifier().WithBudget(10))
+func (w *Handler[T]) GetTransientClassifier() *TransientClassifier {
+	return w.transient
+}
+
+// Wait starts the wait until there's an error or wait is done
+// the last observed result is returned in all cases
+// the returned error is ErrTimeout, ErrCanceled or a *FailedError
+func (w *Handler[T]) Wait(ctx context.Context) (res T, err error) {
 	var done bool
 
-	ctx, cancel := context.WithTimeout(ctx, w.timeout)
-	defer cancel()
+	clk := w.getClock()
+	start := clk.Now()
+	deadline := start.Add(w.timeout)
 
-	ticker := time.NewTicker(w.throttle)
-	defer ticker.Stop()
+	if w.initialDelay > 0 {
+		if err := sleep(ctx, clk, deadline, w.initialDelay); err != nil {
+			return res, err
+		}
+	}
 
-	for {
+	state := ""
+	interval := w.throttle
+	transient := 0
+	for attempt := 1; ; attempt++ {
 		res, done, err = w.fn()
-		if err != nil {
-			return res, errors.Wrap(err, "defined wait function returned an error")
+		if w.onProgress != nil {
+			w.onProgress(attempt, res, clk.Now().Sub(start))
 		}
-		if done {
+		state = w.observeState(state, res)
+		switch {
+		case err == nil && done:
 			return res, nil
+		case err == nil:
+			transient = 0
+		case !w.transient.IsTransient(err):
+			return res, &FailedError{State: state, Err: err}
+		default:
+			// transient errors are tolerated until the budget is exhausted
+			transient++
+			if w.transient.exhausted(transient) {
+				return res, &FailedError{State: state, Err: budgetError(w.transient.Budget, err)}
+			}
+		}
+
+		if err := sleep(ctx, clk, deadline, interval); err != nil {
+			return res, err
 		}
+		interval = w.nextInterval(interval)
+	}
+}
+
+// getClock returns the handler's clock or the real clock if none is set
+func (w *Handler[T]) getClock() clock {
+	if w.clock == nil {
+		return realClock{}
+	}
+	return w.clock
+}
 
-		select {
-		case <-ticker.C:
-			// continue
-		case <-ctx.Done():
-			return res, errors.New("Wait() has timed out")
+// observeState calls the state change hook if the state of res differs from the last state
+// and returns the current state
+func (w *Handler[T]) observeState(last string, res T) string {
+	if w.stateFn == nil {
+		return last
+	}
+	current := w.stateFn(res)
+	if current == "" || current == last {
+		return last
+	}
+	if w.onStateChange != nil {
+		w.onStateChange(last, current, res)
+	}
+	return current
+}
+
+// nextInterval returns the interval for the next attempt
+func (w *Handler[T]) nextInterval(d time.Duration) time.Duration {
+	if w.multiplier <= 1 {
+		return d
+	}
+	next := time.Duration(float64(d) * w.multiplier)
+	if w.maxInterval > 0 && next > w.maxInterval {
+		return w.maxInterval
+	}
+	return next
+}
+
+// sleep waits for the given duration, but not beyond the deadline
+// it returns ErrTimeout if the deadline is reached and ErrCanceled if the context is done first
+func sleep(ctx context.Context, clk clock, deadline time.Time, d time.Duration) error {
+	timeout := false
+	if remaining := deadline.Sub(clk.Now()); remaining <= d {
+		timeout = true
+		d = remaining
+	}
+	if d <= 0 {
+		return ErrTimeout
+	}
+
+	c, stop := clk.After(d)
+	defer stop()
+	select {
+	case <-c:
+		if timeout {
+			return ErrTimeout
 		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %s", ErrCanceled, ctx.Err())
+	}
+}
+
+// WaitFn is the untyped wait function
+//
+// Deprecated: use Fn
+type WaitFn func() (res interface{}, done bool, err error)
+
+// UntypedHandler is the untyped wait handler
+// it was named Handler until Handler became generic, see the migration notes in the README
+//
+// Deprecated: use Handler
+type UntypedHandler struct {
+	fn       WaitFn
+	throttle time.Duration
+	timeout  time.Duration
+}
+
+// New creates a new untyped Wait instance
+//
+// Deprecated: use NewHandler
+func New(f WaitFn) *UntypedHandler {
+	return &UntypedHandler{
+		fn:       f,
+		throttle: DefaultThrottle,
+		timeout:  DefaultTimeout,
+	}
+}
+
+// SetThrottle sets the duration between func triggering
+func (w *UntypedHandler) SetThrottle(d time.Duration) error {
+	if d == 0 {
+		return errors.New("Throttle can't be 0")
+	}
+	w.throttle = d
+	return nil
+}
+
+// SetTimeout sets the duration for wait timeout
+func (w *UntypedHandler) SetTimeout(d time.Duration) *UntypedHandler {
+	w.timeout = d
+	return w
+}
+
+// Wait starts the wait until there's an error or wait is done
+//
+// Deprecated: use WaitWithContext
+func (w *UntypedHandler) Wait() (res interface{}, err error) {
+	return w.WaitWithContext(context.Background())
+}
+
+// WaitWithContext starts the wait until there's an error or wait is done
+func (w UntypedHandler) WaitWithContext(ctx context.Context) (res interface{}, err error) {
+	h := &Handler[interface{}]{
+		fn:       Fn[interface{}](w.fn),
+		throttle: w.throttle,
+		timeout:  w.timeout,
 	}
+	return h.Wait(ctx)
 }
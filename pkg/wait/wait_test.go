@@ -16,9 +16,9 @@ func TestNew(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want *Handler
+		want *UntypedHandler
 	}{
-		{"ok", args{simple}, &Handler{fn: simple, throttle: 5 * time.Second}},
+		{"ok", args{simple}, &UntypedHandler{fn: simple, throttle: 5 * time.Second}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -94,7 +94,7 @@ func TestWait_Run(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := &Handler{
+			w := &UntypedHandler{
 				fn:       tt.fields.fn,
 				throttle: tt.fields.throttle,
 				timeout:  tt.fields.timeout,
@@ -144,7 +144,7 @@ func TestWaitWithCtx_Run(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := &Handler{
+			w := &UntypedHandler{
 				fn:       tt.fields.fn,
 				throttle: tt.fields.throttle,
 				timeout:  tt.fields.timeout,
@@ -159,7 +159,7 @@ func TestWaitWithCtx_Run(t *testing.T) {
 }
 
 func TestWait_SetTimeout(t *testing.T) {
-	f := &Handler{
+	f := &UntypedHandler{
 		throttle: 5 * time.Second,
 		timeout:  5 * time.Hour,
 	}
@@ -175,13 +175,13 @@ func TestWait_SetTimeout(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   *Handler
+		want   *UntypedHandler
 	}{
 		{"ok", fields{timeout: 1 * time.Hour, throttle: 5 * time.Second}, args{d: 5 * time.Hour}, f},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := &Handler{
+			w := &UntypedHandler{
 				throttle: tt.fields.throttle,
 				timeout:  tt.fields.timeout,
 			}
@@ -191,3 +191,248 @@ func TestWait_SetTimeout(t *testing.T) {
 		})
 	}
 }
+
+// fakeClock advances time instantly when a timer is started
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) (<-chan time.Time, func()) {
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch, func() {}
+}
+
+func TestHandler_Wait(t *testing.T) {
+	failure := errors.New("something happened")
+	type fields struct {
+		fn       Fn[int]
+		throttle time.Duration
+		timeout  time.Duration
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		want        int
+		wantErr     error
+		wantElapsed time.Duration
+	}{
+		{"ok", fields{throttle: 5 * time.Second, timeout: 1 * time.Hour, fn: func() (res int, done bool, err error) {
+			return 1, true, nil
+		}}, 1, nil, 0},
+
+		{"err", fields{throttle: 5 * time.Second, timeout: 1 * time.Hour, fn: func() (res int, done bool, err error) {
+			return 2, false, failure
+		}}, 2, ErrFailed, 0},
+
+		{"timeout", fields{throttle: 5 * time.Second, timeout: 30 * time.Minute, fn: func() (res int, done bool, err error) {
+			return 3, false, nil
+		}}, 3, ErrTimeout, 30 * time.Minute},
+
+		{"timeout not aligned with throttle", fields{throttle: 7 * time.Second, timeout: 1 * time.Minute, fn: func() (res int, done bool, err error) {
+			return 4, false, nil
+		}}, 4, ErrTimeout, 1 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := newFakeClock()
+			start := clk.Now()
+			w := &Handler[int]{
+				fn:       tt.fields.fn,
+				throttle: tt.fields.throttle,
+				timeout:  tt.fields.timeout,
+				clock:    clk,
+			}
+			got, err := w.Wait(context.Background())
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("Handler.Wait() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Handler.Wait() = %v, want %v", got, tt.want)
+			}
+			if elapsed := clk.Now().Sub(start); elapsed != tt.wantElapsed {
+				t.Errorf("Handler.Wait() elapsed %v, want %v", elapsed, tt.wantElapsed)
+			}
+		})
+	}
+}
+
+func TestHandler_Wait_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := NewHandler(func() (int, bool, error) { return 0, false, nil })
+	_, err := w.Wait(ctx)
+	if !errors.Is(err, ErrCanceled) {
+		t.Errorf("Handler.Wait() error = %v, want %v", err, ErrCanceled)
+	}
+	if errors.Is(err, ErrTimeout) {
+		t.Errorf("Handler.Wait() error = %v, must not be %v", err, ErrTimeout)
+	}
+}
+
+func TestHandler_Wait_FailedState(t *testing.T) {
+	failure := errors.New("received status FAILED from server")
+	w := NewHandler(func() (string, bool, error) { return "FAILED", false, failure }).
+		SetStateFunc(func(res string) string { return res })
+	w.clock = newFakeClock()
+
+	_, err := w.Wait(context.Background())
+	var fe *FailedError
+	if !errors.As(err, &fe) {
+		t.Fatalf("Handler.Wait() error = %v, want *FailedError", err)
+	}
+	if fe.State != "FAILED" {
+		t.Errorf("FailedError.State = %v, want FAILED", fe.State)
+	}
+	if !errors.Is(err, failure) || !errors.Is(err, ErrFailed) {
+		t.Errorf("Handler.Wait() error = %v, must match the wait function error and ErrFailed", err)
+	}
+}
+
+func TestHandler_Wait_TerminalState(t *testing.T) {
+	attempts := 0
+	w := NewHandler(func() (string, bool, error) {
+		attempts++
+		return "STATE_UNHEALTHY", false, &TerminalError{State: "STATE_UNHEALTHY", Code: "SKE_QUOTA_EXCEEDED", Details: []string{"quota exhausted"}}
+	}).SetStateFunc(func(res string) string { return res })
+	w.clock = newFakeClock()
+
+	_, err := w.Wait(context.Background())
+	if attempts != 1 {
+		t.Errorf("Handler.Wait() attempts = %d, want 1", attempts)
+	}
+	var te *TerminalError
+	if !errors.As(err, &te) {
+		t.Fatalf("Handler.Wait() error = %v, want *TerminalError", err)
+	}
+	if te.Code != "SKE_QUOTA_EXCEEDED" {
+		t.Errorf("TerminalError.Code = %v, want SKE_QUOTA_EXCEEDED", te.Code)
+	}
+	if !errors.Is(err, ErrTerminal) || !errors.Is(err, ErrFailed) {
+		t.Errorf("Handler.Wait() error = %v, must match ErrTerminal and ErrFailed", err)
+	}
+	want := "resource reached a terminal failure state: STATE_UNHEALTHY (SKE_QUOTA_EXCEEDED): quota exhausted"
+	if te.Error() != want {
+		t.Errorf("TerminalError.Error() = %v, want %v", te.Error(), want)
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	polls := 0
+	w := NewHandler(func() (string, bool, error) {
+		polls++
+		return "done", polls == 3, nil
+	})
+	clk := newFakeClock()
+	w.clock = clk
+	got, err := w.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Handler.Wait() error = %v", err)
+	}
+	if got != "done" || polls != 3 {
+		t.Errorf("Handler.Wait() = %v after %d polls, want done after 3", got, polls)
+	}
+	if !reflect.DeepEqual(clk.sleeps, []time.Duration{DefaultThrottle, DefaultThrottle}) {
+		t.Errorf("Handler.Wait() slept %v", clk.sleeps)
+	}
+}
+
+func TestHandler_nextInterval(t *testing.T) {
+	tests := []struct {
+		name        string
+		multiplier  float64
+		maxInterval time.Duration
+		in          time.Duration
+		want        time.Duration
+	}{
+		{"no backoff", 0, 0, time.Second, time.Second},
+		{"multiplier 1", 1, 0, time.Second, time.Second},
+		{"double", 2, 0, time.Second, 2 * time.Second},
+		{"capped", 2, 3 * time.Second, 2 * time.Second, 3 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewHandler(func() (int, bool, error) { return 0, true, nil }).SetBackoff(tt.multiplier, tt.maxInterval)
+			if got := w.nextInterval(tt.in); got != tt.want {
+				t.Errorf("Handler.nextInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_Hooks(t *testing.T) {
+	states := []string{"CREATING", "CREATING", "", "UPDATING", "READY"}
+	polls := 0
+	w := NewHandler(func() (string, bool, error) {
+		res := states[polls]
+		polls++
+		return res, polls == len(states), nil
+	})
+	clk := newFakeClock()
+	w.clock = clk
+
+	attempts := []int{}
+	elapsed := []time.Duration{}
+	changes := []string{}
+	w.SetStateFunc(func(res string) string { return res }).
+		SetBackoff(2, 20*time.Second).
+		OnProgress(func(attempt int, res string, e time.Duration) {
+			attempts = append(attempts, attempt)
+			elapsed = append(elapsed, e)
+		}).
+		OnStateChange(func(from, to string, res string) {
+			changes = append(changes, from+">"+to)
+		})
+
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("Handler.Wait() error = %v", err)
+	}
+	if !reflect.DeepEqual(attempts, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("OnProgress attempts = %v", attempts)
+	}
+	wantElapsed := []time.Duration{0, 5 * time.Second, 15 * time.Second, 35 * time.Second, 55 * time.Second}
+	if !reflect.DeepEqual(elapsed, wantElapsed) {
+		t.Errorf("OnProgress elapsed = %v, want %v", elapsed, wantElapsed)
+	}
+	if !reflect.DeepEqual(changes, []string{">CREATING", "CREATING>UPDATING", "UPDATING>READY"}) {
+		t.Errorf("OnStateChange changes = %v", changes)
+	}
+}
+
+func TestHandler_InitialDelay(t *testing.T) {
+	polled := false
+	w := NewHandler(func() (int, bool, error) {
+		polled = true
+		return 0, true, nil
+	}).SetInitialDelay(time.Hour).SetTimeout(10 * time.Minute)
+	w.clock = newFakeClock()
+
+	if _, err := w.Wait(context.Background()); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Handler.Wait() error = %v, want timeout during initial delay", err)
+	}
+	if polled {
+		t.Error("Handler.Wait() polled during initial delay")
+	}
+
+	clk := newFakeClock()
+	w.SetInitialDelay(30 * time.Second).clock = clk
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Errorf("Handler.Wait() error = %v", err)
+	}
+	if !polled || !reflect.DeepEqual(clk.sleeps, []time.Duration{30 * time.Second}) {
+		t.Errorf("Handler.Wait() slept %v before polling", clk.sleeps)
+	}
+}
@@ -0,0 +1,152 @@
+package watch
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// EventType is the type of a watch event
+type EventType string
+
+const (
+	// EventAdded is emitted when a resource is observed for the first time
+	EventAdded EventType = "ADDED"
+
+	// EventModified is emitted when an observed resource changed
+	EventModified EventType = "MODIFIED"
+
+	// EventDeleted is emitted when an observed resource is no longer listed
+	EventDeleted EventType = "DELETED"
+
+	// EventSynced is emitted for every unchanged resource on resync
+	EventSynced EventType = "SYNCED"
+
+	// EventError is emitted when listing the resources failed
+	EventError EventType = "ERROR"
+)
+
+// Event is a change of a watched resource
+type Event[T any] struct {
+	Type EventType
+
+	// Key identifies the resource, i.e. the cluster name
+	Key string
+
+	// Object is the observed resource
+	// for deleted resources, it's the last observed resource
+	Object T
+
+	// Err is set for error events
+	Err error
+
+	// Time is the time the resources were listed
+	Time time.Time
+}
+
+// ListFn returns the current resources by key
+type ListFn[T any] func(ctx context.Context) (map[string]T, error)
+
+// Watcher polls resources and emits events when they change
+type Watcher[T any] struct {
+	list   ListFn[T]
+	equal  func(a, b T) bool
+	resync time.Duration
+}
+
+// New creates a new watcher
+// by default, resources are compared using reflect.DeepEqual and resync is disabled
+func New[T any](fn ListFn[T]) *Watcher[T] {
+	return &Watcher[T]{
+		list: fn,
+		equal: func(a, b T) bool {
+			return reflect.DeepEqual(a, b)
+		},
+	}
+}
+
+// SetEqual sets the function comparing an observed resource with the last observed one
+// it can be used to ignore fields that change without a status or spec change
+func (w *Watcher[T]) SetEqual(fn func(a, b T) bool) *Watcher[T] {
+	w.equal = fn
+	return w
+}
+
+// SetResync sets the interval after which all unchanged resources are emitted again as EventSynced
+// 0 disables resync
+func (w *Watcher[T]) SetResync(d time.Duration) *Watcher[T] {
+	w.resync = d
+	return w
+}
+
+// Watch polls the resources every interval and emits events on the returned channel
+// the first poll emits EventAdded for every listed resource
+// errors are emitted as EventError and don't stop the watch
+// the channel is closed when ctx is done
+func (w *Watcher[T]) Watch(ctx context.Context, interval time.Duration) <-chan Event[T] {
+	ch := make(chan Event[T])
+	go func() {
+		defer close(ch)
+
+		known := map[string]T{}
+		lastSync := time.Now()
+		for {
+			now := time.Now()
+			resync := w.resync > 0 && now.Sub(lastSync) >= w.resync
+			if resync {
+				lastSync = now
+			}
+
+			for _, e := range w.poll(ctx, known, resync, now) {
+				select {
+				case ch <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			t := time.NewTimer(interval)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// poll lists the resources and returns the events sorted by key
+// known resources are updated in place
+func (w *Watcher[T]) poll(ctx context.Context, known map[string]T, resync bool, now time.Time) []Event[T] {
+	items, err := w.list(ctx)
+	if err != nil {
+		return []Event[T]{{Type: EventError, Err: err, Time: now}}
+	}
+
+	events := []Event[T]{}
+	for k, v := range items {
+		old, ok := known[k]
+		switch {
+		case !ok:
+			events = append(events, Event[T]{Type: EventAdded, Key: k, Object: v, Time: now})
+		case !w.equal(old, v):
+			events = append(events, Event[T]{Type: EventModified, Key: k, Object: v, Time: now})
+		case resync:
+			events = append(events, Event[T]{Type: EventSynced, Key: k, Object: v, Time: now})
+		}
+		known[k] = v
+	}
+	for k, v := range known {
+		if _, ok := items[k]; !ok {
+			events = append(events, Event[T]{Type: EventDeleted, Key: k, Object: v, Time: now})
+			delete(known, k)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Key < events[j].Key
+	})
+	return events
+}
@@ -0,0 +1,106 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	Status  string
+	Updated int
+}
+
+// newTestWatcher returns a watcher that lists the given results in order
+// and keeps returning the last one
+func newTestWatcher(results ...map[string]item) *Watcher[item] {
+	n := 0
+	return New(func(ctx context.Context) (map[string]item, error) {
+		res := results[n]
+		if n < len(results)-1 {
+			n++
+		}
+		if res == nil {
+			return nil, errors.New("list failed")
+		}
+		return res, nil
+	})
+}
+
+func eventTypes(events []Event[item]) []string {
+	res := []string{}
+	for _, e := range events {
+		res = append(res, string(e.Type)+" "+e.Key)
+	}
+	return res
+}
+
+func TestWatcher_poll(t *testing.T) {
+	w := newTestWatcher(
+		map[string]item{"b": {Status: "creating"}, "a": {Status: "ready"}},
+		map[string]item{"b": {Status: "creating"}, "a": {Status: "ready"}},
+		map[string]item{"b": {Status: "ready"}, "a": {Status: "ready"}},
+		nil,
+		map[string]item{"b": {Status: "ready"}},
+	)
+	known := map[string]item{}
+	now := time.Now()
+
+	assert.Equal(t, []string{"ADDED a", "ADDED b"}, eventTypes(w.poll(context.Background(), known, false, now)))
+	assert.Equal(t, []string{}, eventTypes(w.poll(context.Background(), known, false, now)))
+	assert.Equal(t, []string{"MODIFIED b"}, eventTypes(w.poll(context.Background(), known, false, now)))
+
+	events := w.poll(context.Background(), known, false, now)
+	assert.Equal(t, []string{"ERROR "}, eventTypes(events))
+	assert.Error(t, events[0].Err)
+
+	events = w.poll(context.Background(), known, false, now)
+	assert.Equal(t, []string{"DELETED a"}, eventTypes(events))
+	assert.Equal(t, item{Status: "ready"}, events[0].Object)
+	assert.Equal(t, []string{"SYNCED b"}, eventTypes(w.poll(context.Background(), known, true, now)))
+}
+
+func TestWatcher_SetEqual(t *testing.T) {
+	w := newTestWatcher(
+		map[string]item{"a": {Status: "ready", Updated: 1}},
+		map[string]item{"a": {Status: "ready", Updated: 2}},
+		map[string]item{"a": {Status: "failed", Updated: 3}},
+	).SetEqual(func(a, b item) bool {
+		return a.Status == b.Status
+	})
+	known := map[string]item{}
+	now := time.Now()
+
+	assert.Equal(t, []string{"ADDED a"}, eventTypes(w.poll(context.Background(), known, false, now)))
+	assert.Equal(t, []string{}, eventTypes(w.poll(context.Background(), known, false, now)))
+	assert.Equal(t, []string{"MODIFIED a"}, eventTypes(w.poll(context.Background(), known, false, now)))
+}
+
+func TestWatcher_Watch(t *testing.T) {
+	w := newTestWatcher(
+		map[string]item{"a": {Status: "creating"}},
+		map[string]item{"a": {Status: "creating"}},
+		map[string]item{"a": {Status: "ready"}},
+	).SetResync(5 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := w.Watch(ctx, time.Millisecond)
+
+	events := []Event[item]{}
+	for e := range ch {
+		events = append(events, e)
+		if e.Type == EventSynced {
+			cancel()
+		}
+	}
+	if !assert.GreaterOrEqual(t, len(events), 3) {
+		return
+	}
+	assert.Equal(t, []string{"ADDED a", "MODIFIED a"}, eventTypes(events[:2]))
+	assert.Equal(t, EventSynced, events[len(events)-1].Type)
+	assert.Equal(t, item{Status: "ready"}, events[len(events)-1].Object)
+}